@@ -115,6 +115,7 @@ resource "okta_app_signon_policy_rule" "test" {
   re_authentication_frequency = "PT43800H"
   inactivity_period           = "PT2H"
   type                        = "ASSURANCE"
+  risk_score                  = "MEDIUM"
   user_types_excluded         = [
     okta_user_type.test.id
   ]
@@ -144,7 +145,8 @@ resource "okta_app_signon_policy_rule" "test" {
       "possession" : {
         "deviceBound" : "REQUIRED",
         "hardwareProtection" : "REQUIRED",
-        "userPresence" : "OPTIONAL"
+        "userPresence" : "OPTIONAL",
+        "userVerification" : "REQUIRED"
       }
     })
   ]
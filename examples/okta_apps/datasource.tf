@@ -0,0 +1,37 @@
+resource "okta_app_oauth" "test_1" {
+  label          = "testAcc_replace_with_uuid_1"
+  type           = "web"
+  grant_types    = ["authorization_code"]
+  redirect_uris  = ["http://d.com/"]
+  response_types = ["code"]
+}
+
+resource "okta_app_oauth" "test_2" {
+  label          = "testAcc_replace_with_uuid_2"
+  type           = "web"
+  grant_types    = ["authorization_code"]
+  redirect_uris  = ["http://d.com/"]
+  response_types = ["code"]
+}
+
+resource "okta_app_bookmark" "test" {
+  label = "testAcc_replace_with_uuid_bookmark"
+  url   = "https://test.com"
+}
+
+data "okta_apps" "test" {
+  label_prefix = "testAcc_replace_with_uuid"
+  depends_on   = [okta_app_oauth.test_1, okta_app_oauth.test_2, okta_app_bookmark.test]
+}
+
+data "okta_apps" "test_oauth" {
+  label_prefix = "testAcc_replace_with_uuid"
+  sign_on_mode = "OPENID_CONNECT"
+  status       = "ACTIVE"
+  depends_on   = [okta_app_oauth.test_1, okta_app_oauth.test_2, okta_app_bookmark.test]
+}
+
+data "okta_apps" "test_label" {
+  label      = okta_app_bookmark.test.label
+  depends_on = [okta_app_bookmark.test]
+}
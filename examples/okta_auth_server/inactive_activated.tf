@@ -0,0 +1,6 @@
+resource "okta_auth_server" "test" {
+  audiences   = ["whatever.rise.zone"]
+  description = "Staged auth server"
+  name        = "testAcc_replace_with_uuid"
+  status      = "ACTIVE"
+}
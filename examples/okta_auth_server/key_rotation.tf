@@ -0,0 +1,6 @@
+resource "okta_auth_server" "test" {
+  name                         = "testAcc_replace_with_uuid"
+  audiences                    = ["api://selfservice_client_1"]
+  credentials_rotation_mode    = "MANUAL"
+  credentials_rotation_trigger = "1"
+}
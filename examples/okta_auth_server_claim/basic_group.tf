@@ -18,6 +18,16 @@ resource "okta_auth_server_claim" "test_sw" {
   auth_server_id    = okta_auth_server.test.id
 }
 
+resource "okta_auth_server_claim" "test_regex" {
+  name              = "test_regex"
+  status            = "ACTIVE"
+  claim_type        = "IDENTITY"
+  value_type        = "GROUPS"
+  group_filter_type = "REGEX"
+  value             = ".*"
+  auth_server_id    = okta_auth_server.test.id
+}
+
 resource "okta_auth_server" "test" {
   name        = "testAcc_replace_with_uuid"
   description = "test"
@@ -8,3 +8,7 @@ data "okta_auth_server_claim" "test" {
   auth_server_id = okta_auth_server.test.id
   name           = "birthdate"
 }
+
+data "okta_auth_server_claims" "test" {
+  auth_server_id = okta_auth_server.test.id
+}
@@ -0,0 +1,34 @@
+// The groups of the token are limited to the groups whitelisted in the profile of the application
+// that requested the token, the 'getFilteredGroups' function returns at most 50 of them.
+// https://developer.okta.com/docs/guides/customize-tokens-groups-claim/main/#add-a-groups-claim-with-a-dynamic-allowlist
+resource "okta_group" "test" {
+  name = "testAcc_replace_with_uuid"
+}
+
+resource "okta_app_oauth" "test" {
+  label          = "testAcc_replace_with_uuid"
+  type           = "web"
+  grant_types    = ["authorization_code"]
+  redirect_uris  = ["http://d.com/"]
+  response_types = ["code"]
+
+  profile = jsonencode({
+    groups = {
+      whitelist = [okta_group.test.id]
+    }
+  })
+}
+
+resource "okta_auth_server" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "test"
+  audiences   = ["whatever.rise.zone"]
+}
+
+resource "okta_auth_server_claim" "test" {
+  auth_server_id = okta_auth_server.test.id
+  name           = "groups"
+  claim_type     = "IDENTITY"
+  value_type     = "EXPRESSION"
+  value          = "Groups.getFilteredGroups(app.profile.groups.whitelist, \"group.id\", 50)"
+}
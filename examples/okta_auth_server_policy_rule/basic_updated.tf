@@ -10,6 +10,9 @@ resource "okta_auth_server_policy_rule" "test" {
   priority             = 1
   group_whitelist      = [data.okta_group.all.id]
   grant_type_whitelist = ["password"]
+
+  refresh_token_lifetime_minutes = 43200
+  refresh_token_window_minutes   = 1440
 }
 
 resource "okta_auth_server" "test" {
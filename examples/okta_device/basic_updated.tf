@@ -0,0 +1,4 @@
+resource "okta_device" "test" {
+  device_id = "replace_with_device_id"
+  status    = "ACTIVE"
+}
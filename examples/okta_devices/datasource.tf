@@ -0,0 +1,8 @@
+data "okta_devices" "test" {
+  status = "ACTIVE"
+}
+
+data "okta_devices" "test_by_platform" {
+  platform = "MACOS"
+  search   = "profile.registered eq true"
+}
@@ -0,0 +1,9 @@
+data "okta_brands" "test" {
+}
+
+resource "okta_email_domain" "test" {
+  brand_id     = tolist(data.okta_brands.test.brands)[0].id
+  domain       = "testacc-replace_with_uuid.example.com"
+  display_name = "testAcc_replace_with_uuid"
+  user_name    = "no-reply"
+}
@@ -7,6 +7,11 @@ resource "okta_event_hook" "test" {
     "user.account.update_profile",
   ]
 
+  filter {
+    event      = "user.account.update_profile"
+    expression = "event.target.?[type eq 'User' && alternateId eq 'john@example.com'].size() > 0"
+  }
+
   headers {
     key   = "x-test-header"
     value = "test stuff"
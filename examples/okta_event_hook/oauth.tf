@@ -0,0 +1,21 @@
+resource "okta_event_hook" "test" {
+  name   = "testAcc_replace_with_uuid"
+  events = [
+    "user.lifecycle.create",
+    "user.lifecycle.delete.initiated",
+  ]
+
+  channel = {
+    type    = "OAUTH"
+    version = "1.0.0"
+    uri     = "https://example.com/test"
+  }
+
+  oauth {
+    auth_type     = "client_secret_post"
+    client_id     = "abc123"
+    client_secret = "fake-secret"
+    token_url     = "https://example.com/oauth2/v1/token"
+    scope         = "api"
+  }
+}
@@ -0,0 +1,9 @@
+data "okta_feature" "test" {
+  name = "Android Device Trust"
+}
+
+resource "okta_feature" "test" {
+  feature_id = data.okta_feature.test.id
+  status     = "DISABLED"
+  force      = true
+}
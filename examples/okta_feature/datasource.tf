@@ -0,0 +1,7 @@
+data "okta_feature" "test" {
+  name = "Android Device Trust"
+}
+
+data "okta_feature" "test_by_id" {
+  id = data.okta_feature.test.id
+}
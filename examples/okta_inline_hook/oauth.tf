@@ -0,0 +1,20 @@
+resource "okta_inline_hook" "test" {
+  name    = "testAcc_replace_with_uuid"
+  version = "1.0.1"
+  type    = "com.okta.oauth2.tokens.transform"
+
+  channel = {
+    type    = "OAUTH"
+    version = "1.0.0"
+    uri     = "https://example.com/test"
+    method  = "POST"
+  }
+
+  oauth {
+    auth_type     = "client_secret_post"
+    client_id     = "abc123"
+    client_secret = "fake-secret"
+    token_url     = "https://example.com/oauth2/v1/token"
+    scope         = "api"
+  }
+}
@@ -0,0 +1,10 @@
+resource "okta_group" "test" {
+  name        = "testAcc_replace_with_uuid"
+  description = "testing log events"
+}
+
+data "okta_log_events" "test" {
+  filter     = "eventType eq \"group.lifecycle.create\" and target.id eq \"${okta_group.test.id}\""
+  sort_order = "DESCENDING"
+  limit      = 10
+}
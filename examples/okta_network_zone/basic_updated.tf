@@ -3,6 +3,7 @@ resource "okta_network_zone" "ip_network_zone_example" {
   type     = "IP"
   gateways = ["1.2.3.4/24", "2.3.4.5-2.3.4.10"]
   usage    = "BLOCKLIST"
+  status   = "INACTIVE"
 }
 
 resource "okta_network_zone" "dynamic_network_zone_example" {
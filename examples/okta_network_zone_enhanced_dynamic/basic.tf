@@ -0,0 +1,5 @@
+resource "okta_network_zone_enhanced_dynamic" "test" {
+  name                          = "testAcc_replace_with_uuid"
+  usage                         = "BLOCKLIST"
+  ip_service_categories_include = ["ALL_ANONYMIZERS"]
+}
@@ -0,0 +1,8 @@
+resource "okta_network_zone_enhanced_dynamic" "test" {
+  name                          = "testAcc_replace_with_uuid Updated"
+  usage                         = "POLICY"
+  status                        = "INACTIVE"
+  locations_include             = ["US", "CA-ON"]
+  asns_include                  = ["15169"]
+  ip_service_categories_exclude = ["ALL_ANONYMIZERS"]
+}
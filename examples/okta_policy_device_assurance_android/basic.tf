@@ -0,0 +1,8 @@
+resource "okta_policy_device_assurance_android" "test" {
+  name                    = "testAcc_replace_with_uuid"
+  os_version              = "12"
+  disk_encryption_type    = ["FULL", "USER"]
+  jailbreak               = false
+  screen_lock_type        = ["BIOMETRIC"]
+  secure_hardware_present = true
+}
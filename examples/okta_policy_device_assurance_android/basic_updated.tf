@@ -0,0 +1,8 @@
+resource "okta_policy_device_assurance_android" "test" {
+  name                    = "testAcc_replace_with_uuid"
+  os_version              = "13"
+  disk_encryption_type    = ["FULL"]
+  jailbreak               = false
+  screen_lock_type        = ["BIOMETRIC", "PASSCODE"]
+  secure_hardware_present = true
+}
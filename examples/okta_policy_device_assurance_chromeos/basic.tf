@@ -0,0 +1,7 @@
+resource "okta_policy_device_assurance_chromeos" "test" {
+  name = "testAcc_replace_with_uuid"
+  chrome_device_trust {
+    key_trust_level = "CHROME_OS_VERIFIED_MODE"
+    os_version      = "105.0.5195.130"
+  }
+}
@@ -0,0 +1,11 @@
+resource "okta_policy_device_assurance_chromeos" "test" {
+  name = "testAcc_replace_with_uuid"
+  chrome_device_trust {
+    allow_screen_lock        = true
+    device_enrollment_domain = "example.com"
+    disk_encrypted           = true
+    key_trust_level          = "CHROME_OS_VERIFIED_MODE"
+    os_version               = "106.0.5249.103"
+    screen_lock_secured      = true
+  }
+}
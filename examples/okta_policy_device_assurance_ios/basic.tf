@@ -0,0 +1,6 @@
+resource "okta_policy_device_assurance_ios" "test" {
+  name             = "testAcc_replace_with_uuid"
+  os_version       = "15.1"
+  jailbreak        = false
+  screen_lock_type = ["BIOMETRIC"]
+}
@@ -0,0 +1,7 @@
+resource "okta_policy_device_assurance_macos" "test" {
+  name                    = "testAcc_replace_with_uuid"
+  os_version              = "12.4.1"
+  disk_encryption_type    = ["ALL_INTERNAL_VOLUMES"]
+  screen_lock_type        = ["PASSCODE"]
+  secure_hardware_present = true
+}
@@ -0,0 +1,16 @@
+resource "okta_policy_device_assurance_macos" "test" {
+  name                    = "testAcc_replace_with_uuid"
+  os_version              = "13.0"
+  disk_encryption_type    = ["ALL_INTERNAL_VOLUMES"]
+  screen_lock_type        = ["PASSCODE", "BIOMETRIC"]
+  secure_hardware_present = true
+  chrome_device_trust {
+    browser_version         = "106.0.5249.103"
+    disk_encrypted          = true
+    key_trust_level         = "CHROME_BROWSER_HW_KEY"
+    os_firewall             = true
+    screen_lock_secured     = true
+    site_isolation_enabled  = true
+    realtime_url_check_mode = true
+  }
+}
@@ -0,0 +1,7 @@
+resource "okta_policy_device_assurance_windows" "test" {
+  name                    = "testAcc_replace_with_uuid"
+  os_version              = "10.0.19041"
+  disk_encryption_type    = ["ALL_INTERNAL_VOLUMES"]
+  screen_lock_type        = ["PASSCODE"]
+  secure_hardware_present = true
+}
@@ -0,0 +1,17 @@
+resource "okta_policy_device_assurance_windows" "test" {
+  name                    = "testAcc_replace_with_uuid"
+  os_version              = "10.0.22621"
+  disk_encryption_type    = ["ALL_INTERNAL_VOLUMES"]
+  screen_lock_type        = ["PASSCODE", "BIOMETRIC"]
+  secure_hardware_present = true
+  chrome_device_trust {
+    browser_version              = "106.0.5249.103"
+    disk_encrypted               = true
+    key_trust_level              = "CHROME_BROWSER_HW_KEY"
+    os_firewall                  = true
+    screen_lock_secured          = true
+    secure_boot_enabled          = true
+    third_party_blocking_enabled = true
+    windows_machine_domain       = "example.com"
+  }
+}
@@ -30,10 +30,11 @@ resource "okta_network_zone" "test" {
 }
 
 resource "okta_policy_rule_signon" "test" {
-  policy_id = okta_policy_signon.test.id
-  name      = "testAcc_replace_with_uuid"
-  status    = "ACTIVE"
-  access    = "CHALLENGE"
+  policy_id  = okta_policy_signon.test.id
+  name       = "testAcc_replace_with_uuid"
+  status     = "ACTIVE"
+  access     = "CHALLENGE"
+  risk_score = "HIGH"
   behaviors = [
     data.okta_behavior.new_city.id
   ]
@@ -0,0 +1,5 @@
+resource "okta_rate_limiting" "example" {
+  login                  = "PREVIEW"
+  authorize              = "DISABLE"
+  communications_enabled = false
+}
@@ -0,0 +1,9 @@
+resource "okta_user" "test" {
+  first_name                  = "TestAcc"
+  last_name                   = "Staged"
+  login                       = "testAcc-replace_with_uuid@example.com"
+  email                       = "testAcc-replace_with_uuid@example.com"
+  password                    = "SimplePassword123!"
+  status                      = "STAGED"
+  generate_temporary_password = true
+}
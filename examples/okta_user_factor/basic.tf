@@ -0,0 +1,17 @@
+resource "okta_user" "test" {
+  first_name = "TestAcc"
+  last_name  = "Jones"
+  login      = "john_replace_with_uuid@ledzeppelin.com"
+  email      = "john_replace_with_uuid@ledzeppelin.com"
+}
+
+resource "okta_factor" "test_factor" {
+  provider_id = "okta_email"
+  active      = true
+}
+
+resource "okta_user_factor" "test" {
+  user_id     = okta_user.test.id
+  factor_type = "email"
+  depends_on  = [okta_factor.test_factor]
+}
@@ -0,0 +1,12 @@
+resource "okta_user" "test" {
+  first_name = "TestAcc"
+  last_name  = "Smith"
+  login      = "testAcc-replace_with_uuid@example.com"
+  email      = "testAcc-replace_with_uuid@example.com"
+}
+
+resource "okta_user_password" "test" {
+  user_id      = okta_user.test.id
+  password     = "SimplePassword789"
+  old_password = "SimplePassword123"
+}
@@ -0,0 +1,14 @@
+resource "okta_users_parallel" "test" {
+  users {
+    login      = "testAcc-1-replace_with_uuid@example.com"
+    email      = "testAcc-1-replace_with_uuid@example.com"
+    first_name = "TestAcc"
+    last_name  = "One"
+  }
+  users {
+    login      = "testAcc-2-replace_with_uuid@example.com"
+    email      = "testAcc-2-replace_with_uuid@example.com"
+    first_name = "TestAcc"
+    last_name  = "Two"
+  }
+}
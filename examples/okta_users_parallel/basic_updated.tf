@@ -0,0 +1,20 @@
+resource "okta_users_parallel" "test" {
+  users {
+    login      = "testAcc-1-replace_with_uuid@example.com"
+    email      = "testAcc-1-replace_with_uuid@example.com"
+    first_name = "TestAcc"
+    last_name  = "Updated"
+  }
+  users {
+    login      = "testAcc-3-replace_with_uuid@example.com"
+    email      = "testAcc-3-replace_with_uuid@example.com"
+    first_name = "TestAcc"
+    last_name  = "Three"
+  }
+  users {
+    login      = "testAcc-4-replace_with_uuid@example.com"
+    email      = "testAcc-4-replace_with_uuid@example.com"
+    first_name = "TestAcc"
+    last_name  = "Four"
+  }
+}
@@ -13,6 +13,7 @@ require (
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.19.0
 	github.com/okta/okta-sdk-golang/v2 v2.14.1-0.20221028200237-77af9c89f8f3
 	github.com/stretchr/testify v1.8.1
+	gopkg.in/square/go-jose.v2 v2.6.0
 )
 
 require (
@@ -67,6 +68,5 @@ require (
 	google.golang.org/genproto v0.0.0-20211029142109-e255c875f7c7 // indirect
 	google.golang.org/grpc v1.48.0 // indirect
 	google.golang.org/protobuf v1.28.0 // indirect
-	gopkg.in/square/go-jose.v2 v2.6.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
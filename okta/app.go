@@ -94,6 +94,11 @@ var (
 			Computed:    true,
 			Description: "URL of the application's logo",
 		},
+		"embed_url": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The url that can be used to embed this application in other portals.",
+		},
 		"admin_note": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -229,19 +234,62 @@ func appRead(d *schema.ResourceData, name, status, signOn, label string, accy *o
 	_ = d.Set("status", status)
 	_ = d.Set("sign_on_mode", signOn)
 	_ = d.Set("label", label)
-	if accy != nil {
+	setAppAccessibility(d, accy)
+	setAppVisibility(d, vis)
+	setAppNotes(d, notes)
+	if vis != nil {
+		_ = setAppLinks(d, vis.AppLinks)
+	}
+}
+
+// setAppLinkURLs sets the computed attributes derived from the app's "_links"
+func setAppLinkURLs(d *schema.ResourceData, links interface{}) {
+	_ = d.Set("logo_url", linksValue(links, "logo", "href"))
+	_ = d.Set("embed_url", linksValue(links, "appLinks", "href"))
+}
+
+// setAppUserNameTemplate sets the user_name_template_* attributes, shared by app resources and data sources
+func setAppUserNameTemplate(d *schema.ResourceData, tmpl *okta.ApplicationCredentialsUsernameTemplate) {
+	if tmpl == nil {
+		return
+	}
+	_ = d.Set("user_name_template", tmpl.Template)
+	_ = d.Set("user_name_template_type", tmpl.Type)
+	_ = d.Set("user_name_template_suffix", tmpl.Suffix)
+	_ = d.Set("user_name_template_push_status", tmpl.PushStatus)
+}
+
+// setAppNotes sets the admin_note and enduser_note attributes, shared by app resources and data sources
+func setAppNotes(d *schema.ResourceData, notes *okta.ApplicationSettingsNotes) {
+	if notes == nil {
+		return
+	}
+	_ = d.Set("admin_note", notes.Admin)
+	_ = d.Set("enduser_note", notes.Enduser)
+}
+
+// setAppAccessibility sets the accessibility_* attributes, shared by app resources and data sources
+func setAppAccessibility(d *schema.ResourceData, accy *okta.ApplicationAccessibility) {
+	if accy == nil {
+		return
+	}
+	if accy.SelfService != nil {
 		_ = d.Set("accessibility_self_service", *accy.SelfService)
-		_ = d.Set("accessibility_error_redirect_url", accy.ErrorRedirectUrl)
-		_ = d.Set("accessibility_login_redirect_url", accy.LoginRedirectUrl)
+	}
+	_ = d.Set("accessibility_error_redirect_url", accy.ErrorRedirectUrl)
+	_ = d.Set("accessibility_login_redirect_url", accy.LoginRedirectUrl)
+}
+
+// setAppVisibility sets the auto_submit_toolbar, hide_ios and hide_web attributes, shared by app resources and data sources
+func setAppVisibility(d *schema.ResourceData, vis *okta.ApplicationVisibility) {
+	if vis == nil {
+		return
 	}
 	_ = d.Set("auto_submit_toolbar", vis.AutoSubmitToolbar)
-	_ = d.Set("hide_ios", vis.Hide.IOS)
-	_ = d.Set("hide_web", vis.Hide.Web)
-	if notes != nil {
-		_ = d.Set("admin_note", notes.Admin)
-		_ = d.Set("enduser_note", notes.Enduser)
+	if vis.Hide != nil {
+		_ = d.Set("hide_ios", vis.Hide.IOS)
+		_ = d.Set("hide_web", vis.Hide.Web)
 	}
-	_ = setAppLinks(d, vis.AppLinks)
 }
 
 func buildAppSchema(appSchema map[string]*schema.Schema) map[string]*schema.Schema {
@@ -647,6 +695,8 @@ func setSamlSettings(d *schema.ResourceData, signOn *okta.SamlApplicationSetting
 
 	if len(signOn.InlineHooks) > 0 {
 		_ = d.Set("inline_hook_id", signOn.InlineHooks[0].Id)
+	} else {
+		_ = d.Set("inline_hook_id", "")
 	}
 	attrStatements := signOn.AttributeStatements
 	arr := make([]map[string]interface{}, len(attrStatements))
@@ -690,7 +740,7 @@ func deleteApplication(ctx context.Context, d *schema.ResourceData, m interface{
 	err := backoff.Retry(func() error {
 		_, err := client.Application.DeleteApplication(ctx, d.Id())
 		return err
-	}, b)
+	}, backoff.WithContext(b, ctx))
 
 	return err
 }
@@ -2,6 +2,9 @@ package okta
 
 import (
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
 )
 
 func TestLogoStateFunc(t *testing.T) {
@@ -29,3 +32,64 @@ func TestLogoStateFunc(t *testing.T) {
 		}
 	}
 }
+
+func TestAppReadPartialVisibilityAndAccessibility(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAppBookmark().Schema, map[string]interface{}{})
+	appRead(d, "bookmark", statusActive, "BOOKMARK", "example",
+		&okta.ApplicationAccessibility{ErrorRedirectUrl: "https://example.com/error"},
+		&okta.ApplicationVisibility{AutoSubmitToolbar: boolPtr(true)},
+		nil,
+	)
+	if d.Get("auto_submit_toolbar").(bool) != true {
+		t.Errorf("expected auto_submit_toolbar to be true")
+	}
+	if d.Get("accessibility_error_redirect_url").(string) != "https://example.com/error" {
+		t.Errorf("expected accessibility_error_redirect_url to be set, got %q", d.Get("accessibility_error_redirect_url"))
+	}
+	if d.Get("hide_web").(bool) {
+		t.Errorf("expected hide_web to be false when hide settings are absent")
+	}
+	appRead(d, "bookmark", statusInactive, "BOOKMARK", "example", nil, nil, nil)
+	if d.Get("status").(string) != statusInactive {
+		t.Errorf("expected status to be updated, got %q", d.Get("status"))
+	}
+	if !d.Get("auto_submit_toolbar").(bool) || d.Get("accessibility_error_redirect_url").(string) != "https://example.com/error" {
+		t.Errorf("expected visibility and accessibility to be kept when they are absent from the response")
+	}
+}
+
+func TestSetAppLinkURLs(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAppBookmark().Schema, map[string]interface{}{})
+	links := map[string]interface{}{
+		"logo": []interface{}{
+			map[string]interface{}{"name": "medium", "href": "https://example.okta.com/logo.png"},
+		},
+		"appLinks": []interface{}{
+			map[string]interface{}{"name": "bookmark_link", "href": "https://example.okta.com/home/bookmark/0oa1/2557"},
+		},
+	}
+	setAppLinkURLs(d, links)
+	if d.Get("logo_url").(string) != "https://example.okta.com/logo.png" {
+		t.Errorf("unexpected logo_url %q", d.Get("logo_url"))
+	}
+	if d.Get("embed_url").(string) != "https://example.okta.com/home/bookmark/0oa1/2557" {
+		t.Errorf("unexpected embed_url %q", d.Get("embed_url"))
+	}
+}
+
+func TestFlattenAppOAuthProfile(t *testing.T) {
+	cases := []struct {
+		input    interface{}
+		expected string
+	}{
+		{input: nil, expected: ""},
+		{input: map[string]interface{}{}, expected: ""},
+		{input: map[string]interface{}{"tenant": "acme", "groups": []interface{}{"a"}}, expected: `{"groups":["a"],"tenant":"acme"}`},
+	}
+	for _, c := range cases {
+		result := flattenAppOAuthProfile(c.input)
+		if result != c.expected {
+			t.Errorf("expected %q, got %q, for profile %+v", c.expected, result, c.input)
+		}
+	}
+}
@@ -2,10 +2,14 @@ package okta
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"math/rand"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -41,6 +45,7 @@ type (
 		privateKey       string
 		privateKeyId     string
 		scopes           []string
+		dpop             bool
 		retryCount       int
 		parallelism      int
 		backoff          bool
@@ -67,6 +72,15 @@ func (c *Config) loadAndValidate(ctx context.Context) error {
 		Level:      logLevel,
 		TimeFormat: "2006/01/02 03:04:05",
 	})
+	var orgUrl string
+	var disableHTTPS bool
+	if c.httpProxy != "" {
+		orgUrl = strings.TrimSuffix(c.httpProxy, "/")
+		disableHTTPS = strings.HasPrefix(orgUrl, "http://")
+	} else {
+		orgUrl = fmt.Sprintf("https://%v.%v", c.orgName, c.domain)
+	}
+
 	var httpClient *http.Client
 	if c.backoff {
 		retryableClient := retryablehttp.NewClient()
@@ -75,13 +89,24 @@ func (c *Config) loadAndValidate(ctx context.Context) error {
 		retryableClient.RetryMax = c.retryCount
 		retryableClient.Logger = c.logger
 		retryableClient.HTTPClient.Transport = logging.NewTransport("Okta", retryableClient.HTTPClient.Transport)
+		dpopTransport, err := c.dpopTransport(orgUrl, retryableClient.HTTPClient.Transport)
+		if err != nil {
+			return err
+		}
+		retryableClient.HTTPClient.Transport = dpopTransport
 		retryableClient.ErrorHandler = errHandler
 		retryableClient.CheckRetry = checkRetry
+		retryableClient.Backoff = rateLimitBackoff
 		httpClient = retryableClient.StandardClient()
 		c.logger.Info(fmt.Sprintf("running with backoff http client, wait min %d, wait max %d, retry max %d", retryableClient.RetryWaitMin, retryableClient.RetryWaitMax, retryableClient.RetryMax))
 	} else {
 		httpClient = cleanhttp.DefaultClient()
 		httpClient.Transport = logging.NewTransport("Okta", httpClient.Transport)
+		dpopTransport, err := c.dpopTransport(orgUrl, httpClient.Transport)
+		if err != nil {
+			return err
+		}
+		httpClient.Transport = dpopTransport
 		c.logger.Info("running with default http client")
 	}
 
@@ -95,15 +120,6 @@ func (c *Config) loadAndValidate(ctx context.Context) error {
 		httpClient.Transport = transport.NewGovernedTransport(httpClient.Transport, apiMutex, c.logger)
 	}
 
-	var orgUrl string
-	var disableHTTPS bool
-	if c.httpProxy != "" {
-		orgUrl = strings.TrimSuffix(c.httpProxy, "/")
-		disableHTTPS = strings.HasPrefix(orgUrl, "http://")
-	} else {
-		orgUrl = fmt.Sprintf("https://%v.%v", c.orgName, c.domain)
-	}
-
 	setters := []okta.ConfigSetter{
 		okta.WithOrgUrl(orgUrl),
 		okta.WithCache(false),
@@ -127,10 +143,29 @@ func (c *Config) loadAndValidate(ctx context.Context) error {
 			okta.WithToken(c.apiToken), okta.WithAuthorizationMode("SSWS"),
 		)
 
+	// the DPoP transport replaces the authorization of the requests, since okta-sdk-golang doesn't support
+	// DPoP, the SDK is configured with the placeholder bearer token
+	case c.dpop:
+		setters = append(
+			setters,
+			okta.WithToken("DPoP"), okta.WithAuthorizationMode("Bearer"),
+		)
+
 	case c.privateKey != "":
+		if c.clientID == "" || len(c.scopes) == 0 {
+			return errors.New("'client_id' and 'scopes' are required to authenticate with the 'private_key'")
+		}
+		privateKey, keyID, err := normalizePrivateKey(c.privateKey, c.privateKeyId)
+		if err != nil {
+			return fmt.Errorf("invalid private key: %v", err)
+		}
+		// the explicit key ID takes precedence over the one of the JWK
+		if c.privateKeyId != "" {
+			keyID = c.privateKeyId
+		}
 		setters = append(
 			setters,
-			okta.WithPrivateKey(c.privateKey), okta.WithPrivateKeyId(c.privateKeyId), okta.WithScopes(c.scopes), okta.WithClientId(c.clientID), okta.WithAuthorizationMode("PrivateKey"),
+			okta.WithPrivateKey(privateKey), okta.WithPrivateKeyId(keyID), okta.WithScopes(c.scopes), okta.WithClientId(c.clientID), okta.WithAuthorizationMode("PrivateKey"),
 		)
 	}
 
@@ -157,6 +192,33 @@ func (c *Config) loadAndValidate(ctx context.Context) error {
 	return nil
 }
 
+// dpopTransport wraps the base transport with the one that authenticates the requests with the DPoP-bound
+// access tokens of the OAuth 2.0 service app, if DPoP is enabled
+func (c *Config) dpopTransport(orgURL string, base http.RoundTripper) (http.RoundTripper, error) {
+	if !c.dpop {
+		return base, nil
+	}
+	if c.accessToken != "" || c.apiToken != "" || c.privateKey == "" {
+		return nil, errors.New("'dpop' is only supported for the authentication with the 'private_key'")
+	}
+	if c.clientID == "" || len(c.scopes) == 0 {
+		return nil, errors.New("'client_id' and 'scopes' are required to authenticate with the 'private_key'")
+	}
+	privateKeyPEM, keyID, err := normalizePrivateKey(c.privateKey, c.privateKeyId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %v", err)
+	}
+	if c.privateKeyId != "" {
+		keyID = c.privateKeyId
+	}
+	block, _ := pem.Decode([]byte(privateKeyPEM))
+	privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %v", err)
+	}
+	return transport.NewDPoPTransport(base, orgURL, c.clientID, c.scopes, privateKey, keyID)
+}
+
 func errHandler(resp *http.Response, err error, numTries int) (*http.Response, error) {
 	if err != nil {
 		return resp, err
@@ -198,3 +260,34 @@ func checkRetry(ctx context.Context, resp *http.Response, err error) (bool, erro
 	}
 	return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
 }
+
+// maxRateLimitJitter spreads the retries of the concurrent requests, so they don't hit the rate limit again
+// as soon as it resets
+const maxRateLimitJitter = time.Second
+
+// rateLimitBackoff waits until the rate limit resets when the request is rate limited, the wait is
+// between the min and max wait with some jitter. Otherwise, it falls back to the exponential backoff.
+// The SDK and the API supplement share the http client, so both of them are retried the same way.
+func rateLimitBackoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+		return retryablehttp.DefaultBackoff(min, max, attemptNum, resp)
+	}
+	reset, err := strconv.ParseInt(resp.Header.Get(transport.X_RATE_LIMIT_RESET), 10, 64)
+	if err != nil {
+		return retryablehttp.DefaultBackoff(min, max, attemptNum, resp)
+	}
+	// the reset is relative to the server time, in case the local clock is off
+	now := time.Now()
+	if date, err := http.ParseTime(resp.Header.Get("Date")); err == nil {
+		now = date
+	}
+	wait := time.Unix(reset, 0).Sub(now)
+	if wait < min {
+		wait = min
+	}
+	wait += time.Duration(rand.Int63n(int64(maxRateLimitJitter)))
+	if wait > max {
+		wait = max
+	}
+	return wait
+}
@@ -2,13 +2,23 @@ package okta
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"fmt"
+	"net/http"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 )
 
 func TestConfigLoadAndValidate(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey := encodePKCS1PrivateKey(key)
 	tests := []struct {
 		name         string
 		accessToken  string
@@ -23,8 +33,11 @@ func TestConfigLoadAndValidate(t *testing.T) {
 		// NOTE: don't test apiToken, it causes a hit to the wire with a "GET
 		//       /api/v1/users/me" and the test tokens are scrubbed for this test
 		// {"api_token = pass", "", "apiToken", "", "", "", nil, false},
-		{"client_id, private_key, scopes = pass", "", "", "clientID", "privateKey", "", []string{"scope1", "scope2"}, false},
-		{"client_id, private_key, private_key_id, scopes = pass", "", "", "clientID", "privateKey", "privateKeyID", []string{"scope1", "scope2"}, false},
+		{"client_id, private_key, scopes = pass", "", "", "clientID", privateKey, "", []string{"scope1", "scope2"}, false},
+		{"client_id, private_key, private_key_id, scopes = pass", "", "", "clientID", privateKey, "privateKeyID", []string{"scope1", "scope2"}, false},
+		{"client_id, invalid private_key, scopes = fail", "", "", "clientID", "privateKey", "", []string{"scope1", "scope2"}, true},
+		{"private_key without scopes = fail", "", "", "clientID", privateKey, "", nil, true},
+		{"private_key without client_id = fail", "", "", "", privateKey, "", []string{"scope1"}, true},
 	}
 
 	for _, test := range tests {
@@ -50,3 +63,53 @@ func TestConfigLoadAndValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestConfigDPoPTransport(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := Config{dpop: true, clientID: "clientID", privateKey: encodePKCS1PrivateKey(key), scopes: []string{"scope1"}}
+	if _, err := config.dpopTransport("https://test.okta.com", nil); err != nil {
+		t.Errorf("did not expect error but received error: %+v", err)
+	}
+	config = Config{dpop: true, apiToken: "apiToken"}
+	if _, err := config.dpopTransport("https://test.okta.com", nil); err == nil {
+		t.Error("expected error for DPoP with the API token but received none")
+	}
+	config = Config{apiToken: "apiToken"}
+	if tr, err := config.dpopTransport("https://test.okta.com", http.DefaultTransport); err != nil || tr != http.DefaultTransport {
+		t.Error("expected the base transport when DPoP is disabled")
+	}
+}
+
+func TestRateLimitBackoff(t *testing.T) {
+	date := time.Date(2022, 11, 1, 10, 0, 0, 0, time.UTC)
+	rateLimited := func(reset time.Time) *http.Response {
+		resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+		resp.Header.Set("Date", date.Format(http.TimeFormat))
+		resp.Header.Set("X-Rate-Limit-Reset", strconv.FormatInt(reset.Unix(), 10))
+		return resp
+	}
+	min, max := time.Second, time.Minute
+	cases := []struct {
+		name     string
+		resp     *http.Response
+		atLeast  time.Duration
+		atMost   time.Duration
+		attempts int
+	}{
+		{"waits until the reset", rateLimited(date.Add(20 * time.Second)), 20 * time.Second, 21 * time.Second, 1},
+		{"waits the min wait if already reset", rateLimited(date.Add(-time.Second)), min, min + time.Second, 1},
+		{"waits at most the max wait", rateLimited(date.Add(time.Hour)), max, max, 1},
+		{"falls back to the exponential backoff", &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}, 4 * time.Second, 4 * time.Second, 2},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			wait := rateLimitBackoff(min, max, c.attempts, c.resp)
+			if wait < c.atLeast || wait > c.atMost {
+				t.Errorf("expected wait between %s and %s, got %s", c.atLeast, c.atMost, wait)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package okta
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// providerCredentials credentials, which can be sourced from the JSON file or the output of the credentials helper
+type providerCredentials struct {
+	AccessToken  string   `json:"access_token"`
+	APIToken     string   `json:"api_token"`
+	ClientID     string   `json:"client_id"`
+	PrivateKey   string   `json:"private_key"`
+	PrivateKeyID string   `json:"private_key_id"`
+	Scopes       []string `json:"scopes"`
+}
+
+// loadCredentials sets the credentials from the file or the helper, unless one of the auth modes is already set
+// by the provider arguments or the environment variables, so the credentials of the different modes are never mixed
+func (c *Config) loadCredentials(ctx context.Context, file, helper string) error {
+	// the file isn't read and the helper isn't run when the auth mode is already configured
+	if c.accessToken != "" || c.apiToken != "" || c.clientID != "" || c.privateKey != "" {
+		return nil
+	}
+	var (
+		creds *providerCredentials
+		err   error
+	)
+	switch {
+	case file != "":
+		creds, err = readCredentialsFile(file)
+	case helper != "":
+		creds, err = runCredentialsHelper(ctx, helper)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if modes := creds.authModes(); len(modes) > 1 {
+		return fmt.Errorf("credentials must contain only one auth mode, got: %s", strings.Join(modes, ", "))
+	}
+	c.accessToken = creds.AccessToken
+	c.apiToken = creds.APIToken
+	c.clientID = creds.ClientID
+	c.privateKey = creds.PrivateKey
+	if creds.PrivateKeyID != "" {
+		c.privateKeyId = creds.PrivateKeyID
+	}
+	if len(creds.Scopes) != 0 {
+		c.scopes = creds.Scopes
+	}
+	return nil
+}
+
+// authModes returns the auth modes the credentials are set for
+func (p *providerCredentials) authModes() []string {
+	var modes []string
+	if p.AccessToken != "" {
+		modes = append(modes, "access_token")
+	}
+	if p.APIToken != "" {
+		modes = append(modes, "api_token")
+	}
+	if p.ClientID != "" || p.PrivateKey != "" {
+		modes = append(modes, "private_key")
+	}
+	return modes
+}
+
+func readCredentialsFile(file string) (*providerCredentials, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read credentials file: %v", err)
+	}
+	return parseCredentials(content)
+}
+
+// runCredentialsHelper the helper command is run without the shell, its arguments are separated by spaces
+func runCredentialsHelper(ctx context.Context, helper string) (*providerCredentials, error) {
+	args := strings.Fields(helper)
+	if len(args) == 0 {
+		return nil, errors.New("credentials helper command is empty")
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("credentials helper '%s' failed: %v: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return parseCredentials(stdout.Bytes())
+}
+
+func parseCredentials(content []byte) (*providerCredentials, error) {
+	var creds providerCredentials
+	if err := json.Unmarshal(content, &creds); err != nil {
+		return nil, fmt.Errorf("failed to parse credentials: %v", err)
+	}
+	return &creds, nil
+}
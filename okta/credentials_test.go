@@ -0,0 +1,62 @@
+package okta
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestConfigLoadCredentials(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "credentials.json")
+	content := `{"client_id":"clientID","private_key":"privateKey","private_key_id":"keyID","scopes":["okta.users.read"]}`
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	config := Config{apiToken: "configuredToken"}
+	if err := config.loadCredentials(context.TODO(), file, ""); err != nil {
+		t.Fatal(err)
+	}
+	if config.apiToken != "configuredToken" || config.clientID != "" || config.privateKey != "" {
+		t.Errorf("expected configured auth mode to take precedence, got client ID %q", config.clientID)
+	}
+	if err := (&Config{apiToken: "configuredToken"}).loadCredentials(context.TODO(), "", "false"); err != nil {
+		t.Errorf("expected the credentials helper not to run for the configured auth mode, got: %v", err)
+	}
+
+	config = Config{scopes: []string{"okta.groups.read"}}
+	if err := config.loadCredentials(context.TODO(), file, ""); err != nil {
+		t.Fatal(err)
+	}
+	if config.clientID != "clientID" || config.privateKey != "privateKey" || config.privateKeyId != "keyID" {
+		t.Errorf("unexpected private key %q with ID %q", config.privateKey, config.privateKeyId)
+	}
+	if !reflect.DeepEqual(config.scopes, []string{"okta.users.read"}) {
+		t.Errorf("unexpected scopes: %v", config.scopes)
+	}
+
+	config = Config{}
+	if err := config.loadCredentials(context.TODO(), "", "cat "+file); err != nil {
+		t.Fatal(err)
+	}
+	if config.clientID != "clientID" {
+		t.Errorf("expected client ID from the credentials helper, got %q", config.clientID)
+	}
+
+	if err := (&Config{}).loadCredentials(context.TODO(), filepath.Join(t.TempDir(), "missing.json"), ""); err == nil {
+		t.Error("expected error for the missing credentials file")
+	}
+	if err := (&Config{}).loadCredentials(context.TODO(), "", "false"); err == nil {
+		t.Error("expected error for the failed credentials helper")
+	}
+
+	mixed := filepath.Join(t.TempDir(), "mixed.json")
+	if err := os.WriteFile(mixed, []byte(`{"api_token":"token","client_id":"clientID","private_key":"privateKey"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := (&Config{}).loadCredentials(context.TODO(), mixed, ""); err == nil {
+		t.Error("expected error for the credentials with more than one auth mode")
+	}
+}
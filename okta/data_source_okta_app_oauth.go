@@ -64,6 +64,21 @@ func dataSourceAppOauth() *schema.Resource {
 				Computed:    true,
 				Description: "Do not display application icon to users",
 			},
+			"accessibility_self_service": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Enable self service",
+			},
+			"accessibility_error_redirect_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Custom error page URL",
+			},
+			"accessibility_login_redirect_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Custom login page URL",
+			},
 			"grant_types": {
 				Type:        schema.TypeSet,
 				Elem:        &schema.Schema{Type: schema.TypeString},
@@ -130,6 +145,41 @@ func dataSourceAppOauth() *schema.Resource {
 				Computed:    true,
 				Description: "URI to web page providing client policy document.",
 			},
+			"user_name_template": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Username template",
+			},
+			"user_name_template_suffix": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Username template suffix",
+			},
+			"user_name_template_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Username template type",
+			},
+			"user_name_template_push_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Push username on update",
+			},
+			"admin_note": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Application notes for admins.",
+			},
+			"enduser_note": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Application notes for end users.",
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Custom JSON that represents an OAuth application's profile",
+			},
 			"links": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -200,9 +250,8 @@ func dataSourceAppOauthRead(ctx context.Context, d *schema.ResourceData, m inter
 	_ = d.Set("label", app.Label)
 	_ = d.Set("name", app.Name)
 	_ = d.Set("status", app.Status)
-	_ = d.Set("auto_submit_toolbar", app.Visibility.AutoSubmitToolbar)
-	_ = d.Set("hide_ios", app.Visibility.Hide.IOS)
-	_ = d.Set("hide_web", app.Visibility.Hide.Web)
+	setAppVisibility(d, app.Visibility)
+	setAppAccessibility(d, app.Accessibility)
 
 	respTypes := []string{}
 	grantTypes := []string{}
@@ -250,6 +299,13 @@ func dataSourceAppOauthRead(ctx context.Context, d *schema.ResourceData, m inter
 	if err != nil {
 		return diag.Errorf("failed to set OAuth application properties: %v", err)
 	}
+	if app.Settings != nil {
+		setAppNotes(d, app.Settings.Notes)
+	}
+	if app.Credentials != nil {
+		setAppUserNameTemplate(d, app.Credentials.UserNameTemplate)
+	}
+	_ = d.Set("profile", flattenAppOAuthProfile(app.Profile))
 	p, _ := json.Marshal(app.Links)
 	_ = d.Set("links", string(p))
 	return nil
@@ -250,6 +250,16 @@ func dataSourceAppSaml() *schema.Resource {
 				Computed:    true,
 				Description: "x509 encoded certificate that the Service Provider uses to sign Single Logout requests",
 			},
+			"admin_note": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Application notes for admins.",
+			},
+			"enduser_note": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Application notes for end users.",
+			},
 			"links": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -320,6 +330,8 @@ func dataSourceAppSamlRead(ctx context.Context, d *schema.ResourceData, m interf
 	_ = d.Set("name", app.Name)
 	_ = d.Set("status", app.Status)
 	_ = d.Set("key_id", app.Credentials.Signing.Kid)
+	setAppVisibility(d, app.Visibility)
+	setAppAccessibility(d, app.Accessibility)
 	if app.Settings != nil {
 		if app.Settings.SignOn != nil {
 			err = setSamlSettings(d, app.Settings.SignOn)
@@ -333,10 +345,10 @@ func dataSourceAppSamlRead(ctx context.Context, d *schema.ResourceData, m interf
 		}
 	}
 	_ = d.Set("features", convertStringSliceToSetNullable(app.Features))
-	_ = d.Set("user_name_template", app.Credentials.UserNameTemplate.Template)
-	_ = d.Set("user_name_template_type", app.Credentials.UserNameTemplate.Type)
-	_ = d.Set("user_name_template_suffix", app.Credentials.UserNameTemplate.Suffix)
-	_ = d.Set("user_name_template_push_status", app.Credentials.UserNameTemplate.PushStatus)
+	setAppUserNameTemplate(d, app.Credentials.UserNameTemplate)
+	if app.Settings != nil {
+		setAppNotes(d, app.Settings.Notes)
+	}
 	p, _ := json.Marshal(app.Links)
 	_ = d.Set("links", string(p))
 	return nil
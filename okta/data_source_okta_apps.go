@@ -0,0 +1,118 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+func dataSourceApps() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAppsRead,
+		Schema: map[string]*schema.Schema{
+			"label": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Searches for applications with the exact label",
+				ConflictsWith: []string{"label_prefix"},
+			},
+			"label_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Searches for applications which label or name starts with the given value",
+				ConflictsWith: []string{"label"},
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Searches for applications with the given status",
+				ValidateDiagFunc: elemInSlice([]string{statusActive, statusInactive}),
+			},
+			"sign_on_mode": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Searches for applications with the given sign on mode",
+				ValidateDiagFunc: elemInSlice([]string{
+					"AUTO_LOGIN", "BASIC_AUTH", "BOOKMARK", "BROWSER_PLUGIN", "OPENID_CONNECT", "SAML_1_1",
+					"SAML_2_0", "SECURE_PASSWORD_STORE", "WS_FEDERATION",
+				}),
+			},
+			"apps": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sign_on_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAppsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	filters := &appFilters{
+		Label:       d.Get("label").(string),
+		LabelPrefix: d.Get("label_prefix").(string),
+	}
+	if status, ok := d.GetOk("status"); ok {
+		filters.Status = fmt.Sprintf(`status eq "%s"`, status.(string))
+	}
+	appList, err := listApps(ctx, getOktaClientFromMetadata(m), filters, defaultPaginationLimit)
+	if err != nil {
+		return diag.Errorf("failed to list apps: %v", err)
+	}
+	signOnMode := d.Get("sign_on_mode").(string)
+	var arr []map[string]interface{}
+	for _, a := range appList {
+		if !appMatchesFilters(a, filters, signOnMode) {
+			continue
+		}
+		arr = append(arr, map[string]interface{}{
+			"id":           a.Id,
+			"name":         a.Name,
+			"label":        a.Label,
+			"status":       a.Status,
+			"sign_on_mode": a.SignOnMode,
+		})
+	}
+	// the kind of the label filter is part of the ID, since both of them are sent as the same query
+	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(fmt.Sprintf("%s|%s|sign_on_mode: %q", filters.Status, filters, signOnMode)))))
+	_ = d.Set("apps", arr)
+	return nil
+}
+
+// appMatchesFilters Okta API for list apps uses a starts with query on both label and name, so
+// exact label and sign on mode matching has to be done in the provider.
+func appMatchesFilters(a *okta.Application, filters *appFilters, signOnMode string) bool {
+	if filters.Label != "" && a.Label != filters.Label {
+		return false
+	}
+	if signOnMode != "" && a.SignOnMode != signOnMode {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,32 @@
+package okta
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceApps_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(apps)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.okta_apps.test", "apps.#", "3"),
+					resource.TestCheckResourceAttr("data.okta_apps.test_oauth", "apps.#", "2"),
+					resource.TestCheckResourceAttr("data.okta_apps.test_oauth", "apps.0.sign_on_mode", "OPENID_CONNECT"),
+					resource.TestCheckResourceAttr("data.okta_apps.test_label", "apps.#", "1"),
+					resource.TestCheckResourceAttrPair("data.okta_apps.test_label", "apps.0.id", "okta_app_bookmark.test", "id"),
+				),
+			},
+		},
+	})
+}
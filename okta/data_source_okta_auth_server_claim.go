@@ -22,11 +22,13 @@ func dataSourceAuthServerClaim() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"name"},
+				Description:   "ID of the claim, conflicts with `name`",
 			},
 			"name": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"id"},
+				Description:   "Name of the claim, conflicts with `id`",
 			},
 			"scopes": {
 				Type:        schema.TypeSet,
@@ -35,24 +37,34 @@ func dataSourceAuthServerClaim() *schema.Resource {
 				Description: "Auth server claim list of scopes",
 			},
 			"status": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the claim",
 			},
 			"value": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Value of the claim",
 			},
 			"value_type": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the value of the claim: EXPRESSION, GROUPS or SYSTEM",
+			},
+			"group_filter_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the groups filter of the GROUPS claim: STARTS_WITH, EQUALS, CONTAINS or REGEX",
 			},
 			"claim_type": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the token the claim is for: RESOURCE (access token) or IDENTITY (ID token)",
 			},
 			"always_include_in_token": {
-				Type:     schema.TypeBool,
-				Computed: true,
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the claim is always included in the token",
 			},
 		},
 	}
@@ -70,6 +82,9 @@ func dataSourceAuthServerClaimRead(ctx context.Context, d *schema.ResourceData,
 	)
 	if id != "" {
 		claim, _, err = getOktaClientFromMetadata(m).AuthorizationServer.GetOAuth2Claim(ctx, d.Get("auth_server_id").(string), id)
+		if err != nil {
+			err = fmt.Errorf("failed to get authorization server claim: %v", err)
+		}
 	} else {
 		claim, err = getAuthServerClaimByName(ctx, m, d.Get("auth_server_id").(string), name)
 	}
@@ -81,11 +96,14 @@ func dataSourceAuthServerClaimRead(ctx context.Context, d *schema.ResourceData,
 	_ = d.Set("status", claim.Status)
 	_ = d.Set("value", claim.Value)
 	_ = d.Set("value_type", claim.ValueType)
+	_ = d.Set("group_filter_type", claim.GroupFilterType)
 	_ = d.Set("claim_type", claim.ClaimType)
 	_ = d.Set("always_include_in_token", claim.AlwaysIncludeInToken)
-	if claim.Conditions != nil && len(claim.Conditions.Scopes) > 0 {
-		_ = d.Set("scopes", convertStringSliceToSet(claim.Conditions.Scopes))
+	var scopes []string
+	if claim.Conditions != nil {
+		scopes = claim.Conditions.Scopes
 	}
+	_ = d.Set("scopes", convertStringSliceToSetNullable(scopes))
 	return nil
 }
 
@@ -30,6 +30,7 @@ func TestAccOktaDataSourceAuthServerClaim(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
 					resource.TestCheckResourceAttr(resourceName, "claim_type", "IDENTITY"),
+					resource.TestCheckResourceAttrSet(fmt.Sprintf("data.%s.test", authServerClaims), "claims.#"),
 				),
 			},
 		},
@@ -20,42 +20,56 @@ func dataSourceAuthServerClaims() *schema.Resource {
 				Description: "Auth server ID",
 			},
 			"claims": {
-				Type:     schema.TypeList,
-				Computed: true,
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All the claims of the auth server",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the claim",
 						},
 						"name": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the claim",
 						},
 						"scopes": {
-							Type:     schema.TypeSet,
-							Computed: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Scopes of the claim",
 						},
 						"status": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the claim",
 						},
 						"value": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Value of the claim",
 						},
 						"value_type": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the value of the claim: EXPRESSION, GROUPS or SYSTEM",
+						},
+						"group_filter_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the groups filter of the GROUPS claim: STARTS_WITH, EQUALS, CONTAINS or REGEX",
 						},
 						"claim_type": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the token the claim is for: RESOURCE (access token) or IDENTITY (ID token)",
 						},
 						"always_include_in_token": {
-							Type:     schema.TypeBool,
-							Computed: true,
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the claim is always included in the token",
 						},
 					},
 				},
@@ -75,7 +89,10 @@ func dataSourceAuthServerClaimsRead(ctx context.Context, d *schema.ResourceData,
 		s += claims[i].Name
 		arr[i] = flattenClaim(claims[i])
 	}
-	_ = d.Set("claims", arr)
+	err = setNonPrimitives(d, map[string]interface{}{"claims": arr})
+	if err != nil {
+		return diag.Errorf("failed to set authorization server claims: %v", err)
+	}
 	d.SetId(fmt.Sprintf("%s.%d", d.Get("auth_server_id").(string), crc32.ChecksumIEEE([]byte(s))))
 	return nil
 }
@@ -87,6 +104,7 @@ func flattenClaim(c *okta.OAuth2Claim) map[string]interface{} {
 		"status":                  c.Status,
 		"value":                   c.Value,
 		"value_type":              c.ValueType,
+		"group_filter_type":       c.GroupFilterType,
 		"claim_type":              c.ClaimType,
 		"always_include_in_token": c.AlwaysIncludeInToken,
 	}
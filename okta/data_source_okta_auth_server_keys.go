@@ -0,0 +1,165 @@
+package okta
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"hash/crc32"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+func dataSourceAuthServerKeys() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAuthServerKeysRead,
+		Description: "Get the signing keys (JWKS) of an authorization server",
+		Schema: map[string]*schema.Schema{
+			"auth_server_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Auth server ID",
+			},
+			"active_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to return only the ACTIVE keys, keys with NEXT and EXPIRED statuses are returned as well otherwise",
+			},
+			"active_kid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the ACTIVE key which is used to sign the tokens",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Keys of the auth server",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kid": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Key ID",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the key: ACTIVE, NEXT or EXPIRED",
+						},
+						"alg": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Algorithm used with the key",
+						},
+						"kty": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Cryptographic algorithm family of the key",
+						},
+						"use": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Intended use of the key",
+						},
+						"e": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "RSA key value (public exponent)",
+						},
+						"n": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "RSA key value (modulus)",
+						},
+						"pem": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "PEM encoded public key",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAuthServerKeysRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	authServerID := d.Get("auth_server_id").(string)
+	keys, _, err := getOktaClientFromMetadata(m).AuthorizationServer.ListAuthorizationServerKeys(ctx, authServerID)
+	if err != nil {
+		return diag.Errorf("failed to list authorization server keys: %v", err)
+	}
+	activeOnly := d.Get("active_only").(bool)
+	var (
+		s         string
+		activeKid string
+	)
+	arr := make([]map[string]interface{}, 0, len(keys))
+	for _, key := range keys {
+		if key.Status == statusActive {
+			activeKid = key.Kid
+		} else if activeOnly {
+			continue
+		}
+		flattened, err := flattenAuthServerKey(key)
+		if err != nil {
+			return diag.Errorf("failed to read authorization server key '%s': %v", key.Kid, err)
+		}
+		s += key.Kid
+		arr = append(arr, flattened)
+	}
+	_ = d.Set("active_kid", activeKid)
+	err = setNonPrimitives(d, map[string]interface{}{"keys": arr})
+	if err != nil {
+		return diag.Errorf("failed to set authorization server keys: %v", err)
+	}
+	d.SetId(fmt.Sprintf("%s.%d", authServerID, crc32.ChecksumIEEE([]byte(s))))
+	return nil
+}
+
+func flattenAuthServerKey(key *okta.JsonWebKey) (map[string]interface{}, error) {
+	m := map[string]interface{}{
+		"kid":    key.Kid,
+		"status": key.Status,
+		"alg":    key.Alg,
+		"kty":    key.Kty,
+		"use":    key.Use,
+		"e":      key.E,
+		"n":      key.N,
+	}
+	if key.Kty == "RSA" && key.N != "" && key.E != "" {
+		p, err := rsaJWKToPEM(key.N, key.E)
+		if err != nil {
+			return nil, err
+		}
+		m["pem"] = p
+	}
+	return m, nil
+}
+
+// rsaJWKToPEM converts base64url encoded modulus and exponent of the RSA public key to the PEM format
+func rsaJWKToPEM(n, e string) (string, error) {
+	nb, err := base64.RawURLEncoding.DecodeString(n)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode modulus: %v", err)
+	}
+	eb, err := base64.RawURLEncoding.DecodeString(e)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode exponent: %v", err)
+	}
+	pub := &rsa.PublicKey{
+		N: new(big.Int).SetBytes(nb),
+		E: int(new(big.Int).SetBytes(eb).Int64()),
+	}
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal public key: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
 
 func dataSourceAuthServerScopes() *schema.Resource {
@@ -20,41 +21,50 @@ func dataSourceAuthServerScopes() *schema.Resource {
 				Description: "Auth server ID",
 			},
 			"scopes": {
-				Type:     schema.TypeList,
-				Computed: true,
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All the scopes of the auth server",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the scope",
 						},
 						"name": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the scope",
 						},
 						"description": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the scope",
 						},
 						"display_name": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the end user displayed in a consent dialog box",
 						},
 						"consent": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Indicates whether a consent dialog is needed for the scope",
 						},
 						"metadata_publish": {
-							Type:     schema.TypeString,
-							Computed: true,
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Whether the scope should be included in the metadata",
 						},
 						"default": {
-							Type:     schema.TypeBool,
-							Computed: true,
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the scope is a default scope",
 						},
 						"system": {
-							Type:     schema.TypeBool,
-							Computed: true,
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether Okta created the scope",
 						},
 					},
 				},
@@ -64,7 +74,7 @@ func dataSourceAuthServerScopes() *schema.Resource {
 }
 
 func dataSourceAuthServerScopesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	scopes, _, err := getOktaClientFromMetadata(m).AuthorizationServer.ListOAuth2Scopes(ctx, d.Get("auth_server_id").(string), nil)
+	scopes, err := listAuthServerScopes(ctx, getOktaClientFromMetadata(m), d.Get("auth_server_id").(string))
 	if err != nil {
 		return diag.Errorf("failed to list auth server scopes: %v", err)
 	}
@@ -74,11 +84,30 @@ func dataSourceAuthServerScopesRead(ctx context.Context, d *schema.ResourceData,
 		s += scopes[i].Name
 		arr[i] = flattenScope(scopes[i])
 	}
-	_ = d.Set("scopes", arr)
+	err = setNonPrimitives(d, map[string]interface{}{"scopes": arr})
+	if err != nil {
+		return diag.Errorf("failed to set auth server scopes: %v", err)
+	}
 	d.SetId(fmt.Sprintf("%s.%d", d.Get("auth_server_id").(string), crc32.ChecksumIEEE([]byte(s))))
 	return nil
 }
 
+func listAuthServerScopes(ctx context.Context, client *okta.Client, authServerID string) ([]*okta.OAuth2Scope, error) {
+	scopes, resp, err := client.AuthorizationServer.ListOAuth2Scopes(ctx, authServerID, &query.Params{Limit: defaultPaginationLimit})
+	if err != nil {
+		return nil, err
+	}
+	for resp.HasNextPage() {
+		var nextScopes []*okta.OAuth2Scope
+		resp, err = resp.Next(ctx, &nextScopes)
+		if err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, nextScopes...)
+	}
+	return scopes, nil
+}
+
 func flattenScope(s *okta.OAuth2Scope) map[string]interface{} {
 	return map[string]interface{}{
 		"id":               s.Id,
@@ -24,9 +24,19 @@ func dataSourceDefaultPolicy() *schema.Resource {
 					sdk.AccessPolicyType,
 					sdk.ProfileEnrollmentPolicyType,
 				}),
-				Description: fmt.Sprintf("Policy type: %s, %s, %s, or %s", sdk.SignOnPolicyType, sdk.PasswordPolicyType, sdk.MfaPolicyType, sdk.IdpDiscoveryType),
+				Description: fmt.Sprintf("Policy type: %s, %s, %s, %s, %s or %s", sdk.SignOnPolicyType, sdk.PasswordPolicyType, sdk.MfaPolicyType, sdk.IdpDiscoveryType, sdk.AccessPolicyType, sdk.ProfileEnrollmentPolicyType),
 				Required:    true,
 			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the default policy",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the default policy: ACTIVE or INACTIVE",
+			},
 		},
 	}
 }
@@ -38,5 +48,7 @@ func dataSourceDefaultPolicyRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	d.SetId(policy.Id)
+	_ = d.Set("name", policy.Name)
+	_ = d.Set("status", policy.Status)
 	return nil
 }
@@ -23,6 +23,8 @@ func TestAccOktaDataSourceDefaultPolicy_readPasswordPolicy(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.okta_default_policy.default-"+strconv.Itoa(ri), "id"),
+					resource.TestCheckResourceAttr("data.okta_default_policy.default-"+strconv.Itoa(ri), "name", "Default Policy"),
+					resource.TestCheckResourceAttr("data.okta_default_policy.default-"+strconv.Itoa(ri), "status", statusActive),
 				),
 			},
 		},
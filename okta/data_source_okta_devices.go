@@ -0,0 +1,171 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func dataSourceDevices() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceDevicesRead,
+		Description: "Retrieves the registered devices",
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"search"},
+				Description:   "Retrieves only the devices of the user",
+			},
+			"platform": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: elemInSlice(devicePlatforms),
+				Description:      "Platform of the devices",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateDiagFunc: elemInSlice([]string{
+					sdk.DeviceStatusActive, sdk.DeviceStatusCreated,
+					sdk.DeviceStatusDeactivated, sdk.DeviceStatusSuspended,
+				}),
+				Description: "Status of the devices",
+			},
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Search expression for the devices, e.g. profile.displayName sw \"MacBook\"",
+			},
+			"devices": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"platform": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"manufacturer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"model": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"os_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"serial_number": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"registered": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_updated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_ids": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "IDs of the users of the device, not set when the devices are retrieved by the 'user_id'",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDevicesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var (
+		devices []*sdk.Device
+		err     error
+	)
+	platform := d.Get("platform").(string)
+	status := d.Get("status").(string)
+	if userID, ok := d.GetOk("user_id"); ok {
+		devices, err = listUserDevices(ctx, m, userID.(string), platform, status)
+		d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(fmt.Sprintf("%s|%s|%s", userID, platform, status)))))
+	} else {
+		qp := &query.Params{
+			Search: buildDevicesSearch(d.Get("search").(string), platform, status),
+			Expand: "user",
+			Limit:  defaultPaginationLimit,
+		}
+		devices, err = listDevices(ctx, m, qp)
+		d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(qp.String()))))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	arr := make([]map[string]interface{}, len(devices))
+	for i := range devices {
+		arr[i] = flattenDevice(devices[i])
+	}
+	err = d.Set("devices", arr)
+	return diag.FromErr(err)
+}
+
+func listDevices(ctx context.Context, m interface{}, qp *query.Params) ([]*sdk.Device, error) {
+	devices, resp, err := getSupplementFromMetadata(m).ListDevices(ctx, qp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list devices: %v", err)
+	}
+	for resp.HasNextPage() {
+		var nextDevices []*sdk.Device
+		resp, err = resp.Next(ctx, &nextDevices)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list devices: %v", err)
+		}
+		devices = append(devices, nextDevices...)
+	}
+	return devices, nil
+}
+
+// listUserDevices the user devices API doesn't support the search, so the devices are filtered here
+func listUserDevices(ctx context.Context, m interface{}, userID, platform, status string) ([]*sdk.Device, error) {
+	userDevices, _, err := getSupplementFromMetadata(m).ListUserDevices(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list devices of the user: %v", err)
+	}
+	var devices []*sdk.Device
+	for _, userDevice := range userDevices {
+		device := userDevice.Device
+		if device == nil || (status != "" && device.Status != status) {
+			continue
+		}
+		if platform != "" && (device.Profile == nil || device.Profile.Platform != platform) {
+			continue
+		}
+		devices = append(devices, device)
+	}
+	return devices, nil
+}
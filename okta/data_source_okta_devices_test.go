@@ -0,0 +1,50 @@
+package okta
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOktaDevices_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(devices)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.okta_devices.test", "id"),
+					resource.TestCheckResourceAttrSet("data.okta_devices.test", "devices.#"),
+					resource.TestCheckResourceAttrSet("data.okta_devices.test_by_platform", "devices.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestBuildDevicesSearch(t *testing.T) {
+	tests := []struct {
+		search   string
+		platform string
+		status   string
+		expected string
+	}{
+		{"", "", "", ""},
+		{"", "", "ACTIVE", `status eq "ACTIVE"`},
+		{"", "IOS", "ACTIVE", `status eq "ACTIVE" and profile.platform eq "IOS"`},
+		{`profile.displayName sw "Mac"`, "", "", `profile.displayName sw "Mac"`},
+		{`profile.registered eq true or profile.model eq "x"`, "MACOS", "", `profile.platform eq "MACOS" and (profile.registered eq true or profile.model eq "x")`},
+	}
+	for _, test := range tests {
+		if got := buildDevicesSearch(test.search, test.platform, test.status); got != test.expected {
+			t.Errorf("expected %q, got %q", test.expected, got)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
 )
 
 func dataSourceEmailCustomizations() *schema.Resource {
@@ -23,6 +24,11 @@ func dataSourceEmailCustomizations() *schema.Resource {
 					Required:    true,
 					Description: "Template Name",
 				},
+				"default_language": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The language of the default customization of the email template",
+				},
 			},
 			emailCustomizationsDataSourceSchema,
 		),
@@ -30,28 +36,40 @@ func dataSourceEmailCustomizations() *schema.Resource {
 }
 
 func dataSourceEmailCustomizationsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var err error
 	brandID, ok := d.GetOk("brand_id")
 	if !ok {
-		return diag.Errorf("brand_id required for email customizations: %v", err)
+		return diag.Errorf("brand_id required for email customizations")
 	}
 
 	templateName, ok := d.GetOk("template_name")
 	if !ok {
-		return diag.Errorf("template name required for email customizations: %v", err)
+		return diag.Errorf("template name required for email customizations")
 	}
 
-	customizations, _, err := getOktaClientFromMetadata(m).Brand.ListEmailTemplateCustomizations(ctx, brandID.(string), templateName.(string))
+	customizations, resp, err := getOktaClientFromMetadata(m).Brand.ListEmailTemplateCustomizations(ctx, brandID.(string), templateName.(string))
 	if err != nil {
 		return diag.Errorf("failed to list email customizations: %v", err)
 	}
+	for resp.HasNextPage() {
+		var nextCustomizations []*okta.EmailTemplateCustomization
+		resp, err = resp.Next(ctx, &nextCustomizations)
+		if err != nil {
+			return diag.Errorf("failed to list email customizations: %v", err)
+		}
+		customizations = append(customizations, nextCustomizations...)
+	}
 
 	d.SetId(fmt.Sprintf("email_customizations-%s-%s", templateName, brandID.(string)))
 	arr := make([]interface{}, len(customizations))
+	var defaultLanguage string
 	for i, customization := range customizations {
 		rawMap := flattenEmailCustomization(customization)
 		arr[i] = rawMap
+		if customization.IsDefault != nil && *customization.IsDefault {
+			defaultLanguage = customization.Language
+		}
 	}
+	_ = d.Set("default_language", defaultLanguage)
 
 	err = d.Set("email_customizations", schema.NewSet(hashEmailCustomization, arr))
 	if err != nil {
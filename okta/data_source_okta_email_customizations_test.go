@@ -22,6 +22,7 @@ func TestAccDataSourceOktaEmailCustomizations_read(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.okta_email_customizations.forgot_password", "email_customizations.#"),
 					resource.TestCheckResourceAttr("data.okta_email_customizations.forgot_password", "email_customizations.#", "2"),
+					resource.TestCheckResourceAttr("data.okta_email_customizations.forgot_password", "default_language", "en"),
 
 					resource.TestCheckResourceAttrSet("data.okta_email_customizations.forgot_password", "email_customizations.0.id"),
 					resource.TestCheckResourceAttrSet("data.okta_email_customizations.forgot_password", "email_customizations.0.language"),
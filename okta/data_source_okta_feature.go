@@ -0,0 +1,80 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+func dataSourceFeature() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceFeatureRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"name"},
+				Description:   "ID of the feature",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the feature",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the feature",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the feature: ENABLED or DISABLED",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the feature",
+			},
+			"stage_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the release stage: OPEN or CLOSED",
+			},
+			"stage_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Release stage of the feature: EA or BETA",
+			},
+		},
+	}
+}
+
+func dataSourceFeatureRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var feature *okta.Feature
+	featureID, ok := d.GetOk("id")
+	if ok {
+		respFeature, _, err := getOktaClientFromMetadata(m).Feature.GetFeature(ctx, featureID.(string))
+		if err != nil {
+			return diag.Errorf("failed to get feature by ID: %v", err)
+		}
+		feature = respFeature
+	} else {
+		name, ok := d.GetOk("name")
+		if !ok {
+			return diag.Errorf("config must provide either 'id' or 'name' to retrieve the feature")
+		}
+		respFeature, err := findFeatureByName(ctx, m, name.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		feature = respFeature
+	}
+	d.SetId(feature.Id)
+	for k, v := range flattenFeature(feature) {
+		_ = d.Set(k, v)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package okta
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOktaFeature_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(featureResource)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.okta_feature.test", "id"),
+					resource.TestCheckResourceAttrSet("data.okta_feature.test", "status"),
+					resource.TestCheckResourceAttr("data.okta_feature.test_by_id", "name", "Android Device Trust"),
+				),
+			},
+		},
+	})
+}
@@ -2,7 +2,9 @@ package okta
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"path"
 	"strconv"
 	"time"
 
@@ -20,10 +22,13 @@ func dataSourceGroup() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ConflictsWith: []string{"name", "type"},
+				ExactlyOneOf:  []string{"id", "name"},
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Name of the group. The group with the exact name is preferred over the groups which name starts with the given value",
+				ExactlyOneOf: []string{"id", "name"},
 			},
 			"type": {
 				Type:             schema.TypeString,
@@ -35,6 +40,22 @@ func dataSourceGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"custom_profile_attributes": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON formatted custom attributes of the group. For APP_GROUP groups it contains the attributes imported from the app.",
+			},
+			"object_class": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Object classes of the group profile, e.g. 'okta:user_group' or 'okta:windows_security_principal'",
+			},
+			"source_app_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the app the APP_GROUP group is imported from",
+			},
 			"include_users": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -80,31 +101,51 @@ func findGroup(ctx context.Context, name string, d *schema.ResourceData, m inter
 		}
 		group = respGroup
 	} else {
-		searchParams := &query.Params{Q: name, Limit: 1}
+		searchParams := &query.Params{Q: name, Limit: defaultPaginationLimit}
 		t, okType := d.GetOk("type")
 		if okType {
 			searchParams.Filter = fmt.Sprintf("type eq \"%s\"", t.(string))
 		}
 		logger(m).Info("looking for data source group", "query", searchParams.String())
-		groups, _, err := getOktaClientFromMetadata(m).Group.ListGroups(ctx, searchParams)
+		exact, first, err := searchGroupByName(ctx, getOktaClientFromMetadata(m), searchParams, name)
 		switch {
 		case err != nil:
 			return diag.Errorf("failed to query for groups: %v", err)
-		case len(groups) < 1:
+		case first == nil:
 			if okType {
 				return diag.Errorf("group with name '%s' and type '%s' does not exist", name, d.Get("type").(string))
 			}
 			return diag.Errorf("group with name '%s' does not exist", name)
-		case groups[0].Profile.Name != name:
-			logger(m).Warn("group with exact name match was not found: using partial match which contains name as a substring", "name", groups[0].Profile.Name)
 		}
-		group = groups[0]
+		group = exact
+		if group == nil {
+			partialName := ""
+			if first.Profile != nil {
+				partialName = first.Profile.Name
+			}
+			logger(m).Warn("group with exact name match was not found: using partial match which starts with the name", "name", partialName)
+			group = first
+		}
+	}
+	if group.Profile == nil {
+		group.Profile = &okta.GroupProfile{}
 	}
 	d.SetId(group.Id)
 	_ = d.Set("description", group.Profile.Description)
 	if !isEveryone {
 		_ = d.Set("type", group.Type)
 		_ = d.Set("name", group.Profile.Name)
+		_ = d.Set("object_class", group.ObjectClass)
+		_ = d.Set("source_app_id", groupSourceAppID(group))
+		customProfile := ""
+		if len(group.Profile.GroupProfileMap) != 0 {
+			data, err := json.Marshal(group.Profile.GroupProfileMap)
+			if err != nil {
+				return diag.Errorf("failed to read custom profile attributes from group: %s", group.Profile.Name)
+			}
+			customProfile = string(data)
+		}
+		_ = d.Set("custom_profile_attributes", customProfile)
 	}
 	if !d.Get("include_users").(bool) {
 		return nil
@@ -116,3 +157,48 @@ func findGroup(ctx context.Context, name string, d *schema.ResourceData, m inter
 	_ = d.Set("users", convertStringSliceToSet(userIDList))
 	return nil
 }
+
+// searchGroupByName the API query matches the groups which name starts with the given value, so the pages are listed
+// until the group with the exact name is found, the first group is returned as well to be used as the partial match
+func searchGroupByName(ctx context.Context, client *okta.Client, qp *query.Params, name string) (*okta.Group, *okta.Group, error) {
+	groups, resp, err := client.Group.ListGroups(ctx, qp)
+	if err != nil {
+		return nil, nil, err
+	}
+	var first *okta.Group
+	for {
+		if first == nil && len(groups) != 0 {
+			first = groups[0]
+		}
+		for _, g := range groups {
+			if g.Profile != nil && g.Profile.Name == name {
+				return g, first, nil
+			}
+		}
+		if !resp.HasNextPage() {
+			return nil, first, nil
+		}
+		groups = nil
+		resp, err = resp.Next(ctx, &groups)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+}
+
+// groupSourceAppID the source of the imported groups is only available in the links
+func groupSourceAppID(group *okta.Group) string {
+	links, ok := group.Links.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	source, ok := links["source"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	href, _ := source["href"].(string)
+	if href == "" {
+		return ""
+	}
+	return path.Base(href)
+}
@@ -28,6 +28,7 @@ func TestAccOktaDataSourceGroup_read(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.okta_group.test", "id"),
 					resource.TestCheckResourceAttrSet("data.okta_group.test", "type"),
+					resource.TestCheckResourceAttr("data.okta_group.test", "object_class.0", "okta:user_group"),
 					resource.TestCheckResourceAttrSet("okta_group.test", "id"),
 					resource.TestCheckResourceAttr("okta_group.test", "users.#", "1"),
 				),
@@ -77,6 +77,11 @@ func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, m interfa
 	search, ok := d.GetOk("search")
 	if ok {
 		qp.Search = search.(string)
+		// the API doesn't allow the filter to be combined with the search, so the type becomes part of the search
+		if qp.Filter != "" {
+			qp.Search = fmt.Sprintf("(%s) and %s", qp.Search, qp.Filter)
+			qp.Filter = ""
+		}
 	}
 	groups, err := listGroups(ctx, getOktaClientFromMetadata(m), qp)
 	if err != nil {
@@ -0,0 +1,221 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"hash/crc32"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+const maxLogEventsPaginationLimit = 1000
+
+var logEventEntitySchema = map[string]*schema.Schema{
+	"id": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"type": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"alternate_id": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+	"display_name": {
+		Type:     schema.TypeString,
+		Computed: true,
+	},
+}
+
+func dataSourceLogEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLogEventsRead,
+		Description: "Retrieves the System Log events",
+		Schema: map[string]*schema.Schema{
+			"since": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringIsRFC3339Time,
+				Description:      "Lower time bound of the log events in RFC3339 format. Okta defaults it to 7 days before the request",
+			},
+			"until": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: stringIsRFC3339Time,
+				Description:      "Upper time bound of the log events in RFC3339 format",
+			},
+			"filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter expression for the log events, e.g. eventType eq \"user.session.start\"",
+			},
+			"q": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filters the log events by the keywords",
+			},
+			"sort_order": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "ASCENDING",
+				ValidateDiagFunc: elemInSlice([]string{"ASCENDING", "DESCENDING"}),
+				Description:      "Order of the log events by their published time",
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          100,
+				ValidateDiagFunc: intAtLeast(1),
+				Description:      "Maximum number of the log events to return",
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"uuid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"published": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"event_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"legacy_event_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"outcome_result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"outcome_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transaction_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"actor": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Resource{Schema: logEventEntitySchema},
+						},
+						"target": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Resource{Schema: logEventEntitySchema},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLogEventsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	limit := d.Get("limit").(int)
+	qp := &query.Params{
+		Since:     d.Get("since").(string),
+		Until:     d.Get("until").(string),
+		Filter:    d.Get("filter").(string),
+		Q:         d.Get("q").(string),
+		SortOrder: d.Get("sort_order").(string),
+		Limit:     int64(limit),
+	}
+	if limit > maxLogEventsPaginationLimit {
+		qp.Limit = maxLogEventsPaginationLimit
+	}
+	events, resp, err := getOktaClientFromMetadata(m).LogEvent.GetLogs(ctx, qp)
+	if err != nil {
+		return diag.Errorf("failed to list log events: %v", err)
+	}
+	// requests without the 'until' bound are polling requests, which always have the next page,
+	// so the pagination stops as soon as the page is empty
+	for len(events) < limit && resp.HasNextPage() {
+		var nextEvents []*okta.LogEvent
+		resp, err = resp.Next(ctx, &nextEvents)
+		if err != nil {
+			return diag.Errorf("failed to list log events: %v", err)
+		}
+		if len(nextEvents) == 0 {
+			break
+		}
+		events = append(events, nextEvents...)
+	}
+	if len(events) > limit {
+		events = events[:limit]
+	}
+	d.SetId(fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(qp.String()))))
+	arr := make([]map[string]interface{}, len(events))
+	for i := range events {
+		arr[i] = flattenLogEvent(events[i])
+	}
+	err = d.Set("events", arr)
+	return diag.FromErr(err)
+}
+
+func flattenLogEvent(event *okta.LogEvent) map[string]interface{} {
+	m := map[string]interface{}{
+		"uuid":              event.Uuid,
+		"event_type":        event.EventType,
+		"legacy_event_type": event.LegacyEventType,
+		"display_message":   event.DisplayMessage,
+		"severity":          event.Severity,
+	}
+	if event.Published != nil {
+		m["published"] = event.Published.Format(time.RFC3339)
+	}
+	if event.Outcome != nil {
+		m["outcome_result"] = event.Outcome.Result
+		m["outcome_reason"] = event.Outcome.Reason
+	}
+	if event.Client != nil {
+		m["client_ip_address"] = event.Client.IpAddress
+	}
+	if event.Transaction != nil {
+		m["transaction_id"] = event.Transaction.Id
+	}
+	if event.Actor != nil {
+		m["actor"] = []interface{}{map[string]interface{}{
+			"id":           event.Actor.Id,
+			"type":         event.Actor.Type,
+			"alternate_id": event.Actor.AlternateId,
+			"display_name": event.Actor.DisplayName,
+		}}
+	}
+	targets := make([]interface{}, len(event.Target))
+	for i, target := range event.Target {
+		targets[i] = map[string]interface{}{
+			"id":           target.Id,
+			"type":         target.Type,
+			"alternate_id": target.AlternateId,
+			"display_name": target.DisplayName,
+		}
+	}
+	m["target"] = targets
+	return m
+}
@@ -0,0 +1,62 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+func TestAccDataSourceOktaLogEvents_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(logEvents)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	resourceName := fmt.Sprintf("data.%s.test", logEvents)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "events.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "events.0.event_type", "group.lifecycle.create"),
+					resource.TestCheckResourceAttrSet(resourceName, "events.0.actor.0.id"),
+					resource.TestCheckResourceAttrPair(resourceName, "events.0.target.0.id", "okta_group.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenLogEvent(t *testing.T) {
+	published := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	event := flattenLogEvent(&okta.LogEvent{
+		Uuid:      "uuid",
+		EventType: "user.session.start",
+		Published: &published,
+		Outcome:   &okta.LogOutcome{Result: "SUCCESS"},
+		Actor:     &okta.LogActor{Id: "actor", Type: "User"},
+		Target:    []*okta.LogTarget{{Id: "target1"}, {Id: "target2"}},
+	})
+	if event["published"] != "2022-10-01T12:00:00Z" {
+		t.Errorf("unexpected published time: %v", event["published"])
+	}
+	if event["outcome_result"] != "SUCCESS" {
+		t.Errorf("unexpected outcome result: %v", event["outcome_result"])
+	}
+	if actor := event["actor"].([]interface{}); len(actor) != 1 || actor[0].(map[string]interface{})["id"] != "actor" {
+		t.Errorf("unexpected actor: %v", actor)
+	}
+	if targets := event["target"].([]interface{}); len(targets) != 2 {
+		t.Errorf("expected 2 targets, got %d", len(targets))
+	}
+	if _, ok := event["transaction_id"]; ok {
+		t.Error("transaction ID should not be set for the event without the transaction")
+	}
+}
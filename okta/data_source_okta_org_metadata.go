@@ -0,0 +1,113 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func dataSourceOrgMetadata() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOrgMetadataRead,
+		// the cell of the org isn't exposed, since the well-known org metadata doesn't contain it
+		Description: "Retrieves the well-known org metadata, which doesn't require any authorization",
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the org",
+			},
+			"pipeline": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The authentication pipeline of the org: idx means the org is using the Identity Engine, while v1 means the org is using the Classic authentication pipeline",
+			},
+			"domains": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The URLs of the org",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"organization": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Standard Org URL",
+						},
+						"alternate": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Custom Domain Org URL",
+						},
+					},
+				},
+			},
+			"settings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The settings of the org",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"analytics_collection_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"bug_reporting_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"om_enabled": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the legacy Okta Mobile application is enabled for the org",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOrgMetadataRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	org, _, err := getSupplementFromMetadata(m).GetWellKnownOktaOrganization(ctx)
+	if err != nil {
+		return diag.Errorf("failed to get org metadata: %v", err)
+	}
+	d.SetId(org.Id)
+	_ = d.Set("org_id", org.Id)
+	_ = d.Set("pipeline", org.Pipeline)
+	err = setNonPrimitives(d, map[string]interface{}{
+		"domains":  flattenOrgMetadataDomains(org.Links),
+		"settings": flattenOrgMetadataSettings(org.Settings),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set org metadata properties: %v", err)
+	}
+	return nil
+}
+
+func flattenOrgMetadataDomains(links *sdk.OktaOrganizationLinks) []interface{} {
+	if links == nil {
+		return nil
+	}
+	domains := map[string]interface{}{}
+	if links.Organization != nil {
+		domains["organization"] = links.Organization.Href
+	}
+	if links.Alternate != nil {
+		domains["alternate"] = links.Alternate.Href
+	}
+	return []interface{}{domains}
+}
+
+func flattenOrgMetadataSettings(settings *sdk.OktaOrganizationSettings) []interface{} {
+	if settings == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"analytics_collection_enabled": settings.AnalyticsCollectionEnabled,
+		"bug_reporting_enabled":        settings.BugReportingEnabled,
+		"om_enabled":                   settings.OmEnabled,
+	}}
+}
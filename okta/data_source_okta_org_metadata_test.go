@@ -0,0 +1,34 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceOktaOrgMetadata_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(orgMetadata)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+	resourceName := fmt.Sprintf("data.%s.test", orgMetadata)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "org_id", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "pipeline"),
+					resource.TestCheckResourceAttrSet(resourceName, "domains.0.organization"),
+					resource.TestCheckResourceAttr(resourceName, "settings.#", "1"),
+				),
+			},
+		},
+	})
+}
@@ -3,6 +3,7 @@ package okta
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,12 +29,57 @@ func dataSourcePolicy() *schema.Resource {
 					sdk.AccessPolicyType,
 					sdk.ProfileEnrollmentPolicyType,
 				}),
-				Description: fmt.Sprintf("Policy type: %s, %s, %s, or %s", sdk.SignOnPolicyType, sdk.PasswordPolicyType, sdk.MfaPolicyType, sdk.IdpDiscoveryType),
+				Description: fmt.Sprintf("Policy type: %s, %s, %s, %s, %s or %s", sdk.SignOnPolicyType, sdk.PasswordPolicyType, sdk.MfaPolicyType, sdk.IdpDiscoveryType, sdk.AccessPolicyType, sdk.ProfileEnrollmentPolicyType),
 				Required:    true,
 			},
 			"status": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the policy: ACTIVE or INACTIVE",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the policy",
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Priority of the policy",
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Rules of the policy ordered by priority",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the rule",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the rule",
+						},
+						"priority": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Priority of the rule",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the rule: ACTIVE or INACTIVE",
+						},
+						"system": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the rule is the default rule of the policy",
+						},
+					},
+				},
 			},
 		},
 	}
@@ -46,5 +92,28 @@ func dataSourcePolicyRead(ctx context.Context, d *schema.ResourceData, m interfa
 	}
 	d.SetId(policy.Id)
 	_ = d.Set("status", policy.Status)
+	_ = d.Set("description", policy.Description)
+	_ = d.Set("priority", policy.Priority)
+	rules, _, err := getSupplementFromMetadata(m).ListPolicyRules(ctx, policy.Id)
+	if err != nil {
+		return diag.Errorf("failed to list policy rules: %v", err)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Priority < rules[j].Priority
+	})
+	arr := make([]map[string]interface{}, len(rules))
+	for i, rule := range rules {
+		arr[i] = map[string]interface{}{
+			"id":       rule.Id,
+			"name":     rule.Name,
+			"priority": rule.Priority,
+			"status":   rule.Status,
+			"system":   rule.System != nil && *rule.System,
+		}
+	}
+	err = setNonPrimitives(d, map[string]interface{}{"rules": arr})
+	if err != nil {
+		return diag.Errorf("failed to set policy rules: %v", err)
+	}
 	return nil
 }
@@ -20,6 +20,10 @@ func TestAccOktaDataSourcePolicy_read(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.okta_policy.test", "id"),
+					resource.TestCheckResourceAttr("data.okta_policy.test", "status", statusActive),
+					resource.TestCheckResourceAttrSet("data.okta_policy.test", "rules.0.id"),
+					resource.TestCheckResourceAttr("data.okta_policy.test", "rules.0.name", "Default Rule"),
+					resource.TestCheckResourceAttr("data.okta_policy.test", "rules.0.system", "true"),
 				),
 			},
 		},
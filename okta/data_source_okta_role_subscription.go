@@ -15,7 +15,7 @@ func dataSourceRoleSubscription() *schema.Resource {
 			"role_type": {
 				Type:             schema.TypeString,
 				Required:         true,
-				ValidateDiagFunc: elemInSlice(validAdminRoles),
+				ValidateDiagFunc: elemInSlice(validSubscriptionRoles),
 				Description:      "Type of the role",
 			},
 			"notification_type": {
@@ -48,14 +48,16 @@ func dataSourceUser() *schema.Resource {
 		ReadContext: dataSourceUserRead,
 		Schema: buildUserDataSourceSchema(map[string]*schema.Schema{
 			"user_id": {
-				Type:        schema.TypeString,
-				Description: "Retrieve a single user based on their id",
-				Optional:    true,
+				Type:         schema.TypeString,
+				Description:  "Retrieve a single user based on their id",
+				Optional:     true,
+				ExactlyOneOf: []string{"user_id", "search"},
 			},
 			"search": {
-				Type:        schema.TypeSet,
-				Optional:    true,
-				Description: userSearchSchemaDescription,
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Description:  userSearchSchemaDescription,
+				ExactlyOneOf: []string{"user_id", "search"},
 				Elem: &schema.Resource{
 					Schema: userSearchSchema,
 				},
@@ -121,6 +123,8 @@ func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, m interface
 			return diag.Errorf("no users found using search criteria: %+v", sc)
 		}
 		user = users[0]
+	} else {
+		return diag.Errorf("must specify either user_id or search attributes")
 	}
 	d.SetId(user.Id)
 	rawMap := flattenUser(user)
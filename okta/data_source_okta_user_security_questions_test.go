@@ -0,0 +1,30 @@
+package okta
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaDataSourceUserSecurityQuestions_read(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(userSecurityQuestions)
+	config := mgr.GetFixtures("datasource.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.okta_user_security_questions.test", "id", "okta_user.test", "id"),
+					resource.TestCheckResourceAttrSet("data.okta_user_security_questions.test", "questions.0.key"),
+					resource.TestCheckResourceAttrSet("data.okta_user_security_questions.test", "questions.0.text"),
+				),
+			},
+		},
+	})
+}
@@ -18,10 +18,10 @@ func dataSourceUsers() *schema.Resource {
 		ReadContext: dataSourceUsersRead,
 		Schema: map[string]*schema.Schema{
 			"group_id": {
-				Type:          schema.TypeString,
-				Optional:      true,
-				Description:   "Find users based on group membership using the id of the group.",
-				ConflictsWith: []string{"search"},
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Find users based on group membership using the id of the group.",
+				ExactlyOneOf: []string{"group_id", "search"},
 			},
 			"include_groups": {
 				Type:        schema.TypeBool,
@@ -36,10 +36,10 @@ func dataSourceUsers() *schema.Resource {
 				Description: "Fetch user roles for each user",
 			},
 			"search": {
-				Type:          schema.TypeSet,
-				Optional:      true,
-				Description:   userSearchSchemaDescription,
-				ConflictsWith: []string{"group_id"},
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Description:  userSearchSchemaDescription,
+				ExactlyOneOf: []string{"group_id", "search"},
 				Elem: &schema.Resource{
 					Schema: userSearchSchema,
 				},
@@ -94,7 +94,7 @@ func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	if groupId, ok := d.GetOk("group_id"); ok {
 		id = groupId.(string)
-		users, err = listGroupUsers(ctx, m, id)
+		users, _, err = listGroupUsers(ctx, m, id)
 	} else if _, ok := d.GetOk("search"); ok {
 		params := &query.Params{Search: getSearchCriteria(d), Limit: defaultPaginationLimit, SortOrder: "0"}
 		id = fmt.Sprintf("%d", crc32.ChecksumIEEE([]byte(params.String())))
@@ -116,7 +116,7 @@ func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, m interfac
 		if includeGroups {
 			groups, err := getGroupsForUser(ctx, user.Id, client)
 			if err != nil {
-				return diag.Errorf("failed to list users: %v", err)
+				return diag.Errorf("failed to set user's groups: %v", err)
 			}
 			rawMap["group_memberships"] = groups
 		}
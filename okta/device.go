@@ -0,0 +1,102 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+var devicePlatforms = []string{"ANDROID", "IOS", "MACOS", "WINDOWS"}
+
+// buildDevicesSearch combines the status and platform conditions with the custom search expression
+func buildDevicesSearch(search, platform, status string) string {
+	var conditions []string
+	if status != "" {
+		conditions = append(conditions, fmt.Sprintf("status eq \"%s\"", status))
+	}
+	if platform != "" {
+		conditions = append(conditions, fmt.Sprintf("profile.platform eq \"%s\"", platform))
+	}
+	if search != "" {
+		if len(conditions) == 0 {
+			return search
+		}
+		conditions = append(conditions, fmt.Sprintf("(%s)", search))
+	}
+	return strings.Join(conditions, " and ")
+}
+
+func flattenDevice(device *sdk.Device) map[string]interface{} {
+	m := map[string]interface{}{
+		"id":     device.Id,
+		"status": device.Status,
+	}
+	if device.Created != nil {
+		m["created"] = device.Created.Format(time.RFC3339)
+	}
+	if device.LastUpdated != nil {
+		m["last_updated"] = device.LastUpdated.Format(time.RFC3339)
+	}
+	if device.Profile != nil {
+		m["display_name"] = device.Profile.DisplayName
+		m["platform"] = device.Profile.Platform
+		m["manufacturer"] = device.Profile.Manufacturer
+		m["model"] = device.Profile.Model
+		m["os_version"] = device.Profile.OsVersion
+		m["serial_number"] = device.Profile.SerialNumber
+		if device.Profile.Registered != nil {
+			m["registered"] = *device.Profile.Registered
+		}
+	}
+	var userIDs []interface{}
+	if device.Embedded != nil {
+		for _, user := range device.Embedded.Users {
+			if user.User != nil {
+				userIDs = append(userIDs, user.User.Id)
+			}
+		}
+	}
+	m["user_ids"] = userIDs
+	return m
+}
+
+// changeDeviceStatus moves the device to the target status, only active devices can be suspended,
+// so the deactivated ones are activated first
+func changeDeviceStatus(ctx context.Context, m interface{}, device *sdk.Device, status string) error {
+	if device.Status == status {
+		return nil
+	}
+	client := getSupplementFromMetadata(m)
+	switch status {
+	case sdk.DeviceStatusActive:
+		var err error
+		if device.Status == sdk.DeviceStatusSuspended {
+			_, err = client.UnsuspendDevice(ctx, device.Id)
+		} else {
+			_, err = client.ActivateDevice(ctx, device.Id)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to activate device: %v", err)
+		}
+	case sdk.DeviceStatusSuspended:
+		if device.Status != sdk.DeviceStatusActive {
+			_, err := client.ActivateDevice(ctx, device.Id)
+			if err != nil {
+				return fmt.Errorf("failed to activate device: %v", err)
+			}
+		}
+		_, err := client.SuspendDevice(ctx, device.Id)
+		if err != nil {
+			return fmt.Errorf("failed to suspend device: %v", err)
+		}
+	case sdk.DeviceStatusDeactivated:
+		_, err := client.DeactivateDevice(ctx, device.Id)
+		if err != nil {
+			return fmt.Errorf("failed to deactivate device: %v", err)
+		}
+	}
+	return nil
+}
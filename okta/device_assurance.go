@@ -0,0 +1,329 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// The device assurance resources are defined in the resource_okta_policy_device_assurance_<platform>_policy.go
+// files, since the _android, _ios and _windows file name suffixes are treated as build constraints by Go.
+
+// Basis of device assurance policy schema
+var baseDeviceAssuranceSchema = map[string]*schema.Schema{
+	"name": {
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Name of the device assurance policy",
+	},
+	"os_version": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Minimum version of the operating system",
+	},
+	"created_by": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "ID of the user who created the device assurance policy",
+	},
+	"created_date": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Creation date of the device assurance policy",
+	},
+	"last_update": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Last update date of the device assurance policy",
+	},
+	"last_updated_by": {
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "ID of the user who last updated the device assurance policy",
+	},
+}
+
+func buildDeviceAssuranceSchema(target map[string]*schema.Schema) map[string]*schema.Schema {
+	return buildSchema(baseDeviceAssuranceSchema, target)
+}
+
+// createDeviceAssuranceImporter imports the device assurance policy only when it is of the expected
+// platform, since the policies of all the platforms share the same API
+func createDeviceAssuranceImporter(platform string) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			da, _, err := getSupplementFromMetadata(m).GetDeviceAssurance(ctx, d.Id())
+			if err != nil {
+				return nil, fmt.Errorf("failed to get device assurance policy: %v", err)
+			}
+			if da.Platform != platform {
+				return nil, fmt.Errorf("device assurance policy '%s' is of the '%s' platform, expected '%s'", d.Id(), da.Platform, platform)
+			}
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+func buildDeviceAssurance(d *schema.ResourceData, platform string) sdk.DeviceAssurance {
+	da := sdk.DeviceAssurance{
+		Name:     d.Get("name").(string),
+		Platform: platform,
+	}
+	if v, ok := d.GetOk("os_version"); ok {
+		da.OsVersion = buildDeviceAssuranceVersion(v.(string))
+	}
+	return da
+}
+
+func getDeviceAssurance(ctx context.Context, d *schema.ResourceData, m interface{}) (*sdk.DeviceAssurance, error) {
+	da, resp, err := getSupplementFromMetadata(m).GetDeviceAssurance(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return nil, err
+	}
+	return da, nil
+}
+
+func syncDeviceAssurance(d *schema.ResourceData, da *sdk.DeviceAssurance) {
+	_ = d.Set("name", da.Name)
+	// ChromeOS policies don't have the os_version, the OS version is one of the Chrome Device Trust signals
+	if _, ok := d.GetOk("os_version"); ok || da.OsVersion != nil {
+		_ = d.Set("os_version", flattenDeviceAssuranceVersion(da.OsVersion))
+	}
+	_ = d.Set("created_by", da.CreatedBy)
+	_ = d.Set("created_date", da.CreatedDate)
+	_ = d.Set("last_update", da.LastUpdate)
+	_ = d.Set("last_updated_by", da.LastUpdatedBy)
+}
+
+func deleteDeviceAssurance(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	resp, err := getSupplementFromMetadata(m).DeleteDeviceAssurance(ctx, d.Id())
+	return suppressErrorOn404(resp, err)
+}
+
+// deviceAssuranceBool returns nil when the attribute is absent from the configuration, so that "false"
+// can be sent to the API, which is the value used to restrict e.g. jailbroken devices
+func deviceAssuranceBool(d *schema.ResourceData, key string) *bool {
+	v := d.GetRawConfig().GetAttr(key)
+	if v.IsNull() {
+		return nil
+	}
+	return boolPtr(v.True())
+}
+
+func buildDeviceAssuranceInclude(d *schema.ResourceData, key string) *sdk.DeviceAssuranceIncludeCondition {
+	include := convertInterfaceToStringSetNullable(d.Get(key))
+	if len(include) == 0 {
+		return nil
+	}
+	return &sdk.DeviceAssuranceIncludeCondition{Include: include}
+}
+
+func flattenDeviceAssuranceInclude(condition *sdk.DeviceAssuranceIncludeCondition) *schema.Set {
+	if condition == nil {
+		return convertStringSliceToSet(nil)
+	}
+	return convertStringSliceToSet(condition.Include)
+}
+
+// deviceAssurancePostureChecksSchema custom device posture checks of the desktop platforms
+var deviceAssurancePostureChecksSchema = &schema.Schema{
+	Type:        schema.TypeSet,
+	Optional:    true,
+	Description: "Variable names of the custom device posture checks, that the device is required to pass",
+	Elem:        &schema.Schema{Type: schema.TypeString},
+}
+
+func buildDeviceAssurancePostureChecks(d *schema.ResourceData) *sdk.DeviceAssurancePostureChecks {
+	names := convertInterfaceToStringSetNullable(d.Get("device_posture_checks"))
+	if len(names) == 0 {
+		return nil
+	}
+	checks := &sdk.DeviceAssurancePostureChecks{Include: make([]sdk.DeviceAssurancePostureCheck, len(names))}
+	for i, name := range names {
+		checks.Include[i] = sdk.DeviceAssurancePostureCheck{VariableName: name}
+	}
+	return checks
+}
+
+func flattenDeviceAssurancePostureChecks(checks *sdk.DeviceAssurancePostureChecks) *schema.Set {
+	var names []string
+	if checks != nil {
+		for _, check := range checks.Include {
+			names = append(names, check.VariableName)
+		}
+	}
+	return convertStringSliceToSet(names)
+}
+
+// Basis of the Chrome Device Trust signals schema, which is shared by the desktop platforms
+var baseDeviceAssuranceDTCSchema = map[string]*schema.Schema{
+	"browser_version": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Minimum version of the Chrome browser",
+	},
+	"builtin_dns_client_enabled": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether the built-in DNS client of Chrome is required to be enabled",
+	},
+	"chrome_remote_desktop_app_blocked": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether the Chrome Remote Desktop application is required to be blocked",
+	},
+	"device_enrollment_domain": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Domain the device is required to be enrolled in",
+	},
+	"disk_encrypted": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether the disk of the device is required to be encrypted",
+	},
+	"key_trust_level": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Trust level of the key used to sign the signals: CHROME_BROWSER_HW_KEY or CHROME_BROWSER_OS_KEY",
+		ValidateDiagFunc: elemInSlice([]string{"CHROME_BROWSER_HW_KEY", "CHROME_BROWSER_OS_KEY"}),
+	},
+	"os_firewall": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether the firewall of the operating system is required to be enabled",
+	},
+	"os_version": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Minimum version of the operating system reported by Chrome",
+	},
+	"password_protection_warning_trigger": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Password protection warning trigger: PASSWORD_PROTECTION_OFF, PASSWORD_REUSE or PHISHING_REUSE",
+		ValidateDiagFunc: elemInSlice([]string{"PASSWORD_PROTECTION_OFF", "PASSWORD_REUSE", "PHISHING_REUSE"}),
+	},
+	"realtime_url_check_mode": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether the real-time URL check of Safe Browsing is required to be enabled",
+	},
+	"safe_browsing_protection_level": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Safe Browsing protection level: ENHANCED_PROTECTION, STANDARD_PROTECTION or SAFE_BROWSING_PROTECTION_OFF",
+		ValidateDiagFunc: elemInSlice([]string{"ENHANCED_PROTECTION", "STANDARD_PROTECTION", "SAFE_BROWSING_PROTECTION_OFF"}),
+	},
+	"screen_lock_secured": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether the screen lock of the device is required to be secured",
+	},
+	"site_isolation_enabled": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether the site isolation of Chrome is required to be enabled",
+	},
+}
+
+func buildDeviceAssuranceDTCResource(target map[string]*schema.Schema) *schema.Resource {
+	return &schema.Resource{Schema: buildSchema(baseDeviceAssuranceDTCSchema, target)}
+}
+
+func buildDeviceAssuranceDTC(d *schema.ResourceData) *sdk.DeviceAssuranceThirdPartySignalProviders {
+	raw := d.Get("chrome_device_trust").([]interface{})
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+	m := raw[0].(map[string]interface{})
+	return &sdk.DeviceAssuranceThirdPartySignalProviders{
+		Dtc: &sdk.DeviceAssuranceDTC{
+			AllowScreenLock:                  deviceAssuranceSignal(m, "allow_screen_lock"),
+			BrowserVersion:                   buildDeviceAssuranceVersion(getMapString(m, "browser_version")),
+			BuiltInDnsClientEnabled:          deviceAssuranceSignal(m, "builtin_dns_client_enabled"),
+			ChromeRemoteDesktopAppBlocked:    deviceAssuranceSignal(m, "chrome_remote_desktop_app_blocked"),
+			CrowdStrikeAgentId:               getMapString(m, "crowd_strike_agent_id"),
+			CrowdStrikeCustomerId:            getMapString(m, "crowd_strike_customer_id"),
+			DeviceEnrollmentDomain:           getMapString(m, "device_enrollment_domain"),
+			DiskEncrypted:                    deviceAssuranceSignal(m, "disk_encrypted"),
+			KeyTrustLevel:                    getMapString(m, "key_trust_level"),
+			OsFirewall:                       deviceAssuranceSignal(m, "os_firewall"),
+			OsVersion:                        buildDeviceAssuranceVersion(getMapString(m, "os_version")),
+			PasswordProtectionWarningTrigger: getMapString(m, "password_protection_warning_trigger"),
+			RealtimeUrlCheckMode:             deviceAssuranceSignal(m, "realtime_url_check_mode"),
+			SafeBrowsingProtectionLevel:      getMapString(m, "safe_browsing_protection_level"),
+			ScreenLockSecured:                deviceAssuranceSignal(m, "screen_lock_secured"),
+			SecureBootEnabled:                deviceAssuranceSignal(m, "secure_boot_enabled"),
+			SiteIsolationEnabled:             deviceAssuranceSignal(m, "site_isolation_enabled"),
+			ThirdPartyBlockingEnabled:        deviceAssuranceSignal(m, "third_party_blocking_enabled"),
+			WindowsMachineDomain:             getMapString(m, "windows_machine_domain"),
+			WindowsUserDomain:                getMapString(m, "windows_user_domain"),
+		},
+	}
+}
+
+// flattenDeviceAssuranceDTC only the signals defined in the platform specific schema are flattened
+func flattenDeviceAssuranceDTC(tpsp *sdk.DeviceAssuranceThirdPartySignalProviders, r *schema.Resource) []interface{} {
+	if tpsp == nil || tpsp.Dtc == nil {
+		return nil
+	}
+	dtc := tpsp.Dtc
+	m := map[string]interface{}{
+		"allow_screen_lock":                   isDeviceAssuranceSignalSet(dtc.AllowScreenLock),
+		"browser_version":                     flattenDeviceAssuranceVersion(dtc.BrowserVersion),
+		"builtin_dns_client_enabled":          isDeviceAssuranceSignalSet(dtc.BuiltInDnsClientEnabled),
+		"chrome_remote_desktop_app_blocked":   isDeviceAssuranceSignalSet(dtc.ChromeRemoteDesktopAppBlocked),
+		"crowd_strike_agent_id":               dtc.CrowdStrikeAgentId,
+		"crowd_strike_customer_id":            dtc.CrowdStrikeCustomerId,
+		"device_enrollment_domain":            dtc.DeviceEnrollmentDomain,
+		"disk_encrypted":                      isDeviceAssuranceSignalSet(dtc.DiskEncrypted),
+		"key_trust_level":                     dtc.KeyTrustLevel,
+		"os_firewall":                         isDeviceAssuranceSignalSet(dtc.OsFirewall),
+		"os_version":                          flattenDeviceAssuranceVersion(dtc.OsVersion),
+		"password_protection_warning_trigger": dtc.PasswordProtectionWarningTrigger,
+		"realtime_url_check_mode":             isDeviceAssuranceSignalSet(dtc.RealtimeUrlCheckMode),
+		"safe_browsing_protection_level":      dtc.SafeBrowsingProtectionLevel,
+		"screen_lock_secured":                 isDeviceAssuranceSignalSet(dtc.ScreenLockSecured),
+		"secure_boot_enabled":                 isDeviceAssuranceSignalSet(dtc.SecureBootEnabled),
+		"site_isolation_enabled":              isDeviceAssuranceSignalSet(dtc.SiteIsolationEnabled),
+		"third_party_blocking_enabled":        isDeviceAssuranceSignalSet(dtc.ThirdPartyBlockingEnabled),
+		"windows_machine_domain":              dtc.WindowsMachineDomain,
+		"windows_user_domain":                 dtc.WindowsUserDomain,
+	}
+	for k := range m {
+		if _, ok := r.Schema[k]; !ok {
+			delete(m, k)
+		}
+	}
+	return []interface{}{m}
+}
+
+// deviceAssuranceSignal the signals are only sent when they are required, since a disabled signal
+// doesn't restrict the devices
+func deviceAssuranceSignal(m map[string]interface{}, key string) *bool {
+	if v, _ := m[key].(bool); v {
+		return boolPtr(true)
+	}
+	return nil
+}
+
+func isDeviceAssuranceSignalSet(signal *bool) bool {
+	return signal != nil && *signal
+}
+
+func buildDeviceAssuranceVersion(minimum string) *sdk.DeviceAssuranceVersion {
+	if minimum == "" {
+		return nil
+	}
+	return &sdk.DeviceAssuranceVersion{Minimum: minimum}
+}
+
+func flattenDeviceAssuranceVersion(version *sdk.DeviceAssuranceVersion) string {
+	if version == nil {
+		return ""
+	}
+	return version.Minimum
+}
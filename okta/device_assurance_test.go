@@ -0,0 +1,20 @@
+package okta
+
+import (
+	"testing"
+)
+
+func TestDeviceAssurancePostureChecks(t *testing.T) {
+	d := resourcePolicyDeviceAssuranceMacOS().TestResourceData()
+	if checks := buildDeviceAssurancePostureChecks(d); checks != nil {
+		t.Errorf("expected no device posture checks, got %+v", checks)
+	}
+	_ = d.Set("device_posture_checks", []interface{}{"custom_check_gatekeeper_enabled"})
+	checks := buildDeviceAssurancePostureChecks(d)
+	if checks == nil || len(checks.Include) != 1 || checks.Include[0].VariableName != "custom_check_gatekeeper_enabled" {
+		t.Fatalf("unexpected device posture checks: %+v", checks)
+	}
+	if names := flattenDeviceAssurancePostureChecks(checks); names.Len() != 1 || !names.Contains("custom_check_gatekeeper_enabled") {
+		t.Errorf("unexpected flattened device posture checks: %v", names.List())
+	}
+}
@@ -63,11 +63,13 @@ var emailCustomizationResourceSchema = map[string]*schema.Schema{
 	"brand_id": {
 		Type:        schema.TypeString,
 		Required:    true,
+		ForceNew:    true,
 		Description: "Brand ID",
 	},
 	"template_name": {
 		Type:        schema.TypeString,
 		Required:    true,
+		ForceNew:    true,
 		Description: "Template Name",
 	},
 	"links": {
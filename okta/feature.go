@@ -0,0 +1,43 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+const (
+	featureEnabled  = "ENABLED"
+	featureDisabled = "DISABLED"
+)
+
+// findFeatureByName the features API doesn't support the search, so the whole list is fetched
+func findFeatureByName(ctx context.Context, m interface{}, name string) (*okta.Feature, error) {
+	features, _, err := getOktaClientFromMetadata(m).Feature.ListFeatures(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list features: %v", err)
+	}
+	for _, feature := range features {
+		if feature.Name == name {
+			return feature, nil
+		}
+	}
+	return nil, fmt.Errorf("feature with name '%s' does not exist", name)
+}
+
+func flattenFeature(feature *okta.Feature) map[string]interface{} {
+	attrs := map[string]interface{}{
+		"name":        feature.Name,
+		"description": feature.Description,
+		"status":      feature.Status,
+		"type":        feature.Type,
+		"stage_state": "",
+		"stage_value": "",
+	}
+	if feature.Stage != nil {
+		attrs["stage_state"] = feature.Stage.State
+		attrs["stage_value"] = feature.Stage.Value
+	}
+	return attrs
+}
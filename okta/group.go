@@ -8,11 +8,12 @@ import (
 	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
 
-func listGroupUsers(ctx context.Context, m interface{}, id string) ([]*okta.User, error) {
+// listGroupUsers the response is returned, so the callers can tell the missing group apart from the other errors
+func listGroupUsers(ctx context.Context, m interface{}, id string) ([]*okta.User, *okta.Response, error) {
 	var resUsers []*okta.User
 	users, resp, err := getOktaClientFromMetadata(m).Group.ListGroupUsers(ctx, id, &query.Params{Limit: defaultPaginationLimit})
 	if err != nil {
-		return nil, err
+		return nil, resp, err
 	}
 	for {
 		resUsers = append(resUsers, users...)
@@ -20,19 +21,19 @@ func listGroupUsers(ctx context.Context, m interface{}, id string) ([]*okta.User
 			users = []*okta.User{}
 			resp, err = resp.Next(ctx, &users)
 			if err != nil {
-				return nil, err
+				return nil, resp, err
 			}
 			continue
 		} else {
 			break
 		}
 	}
-	return resUsers, nil
+	return resUsers, resp, nil
 }
 
 func listGroupUserIDs(ctx context.Context, m interface{}, id string) ([]string, error) {
 	var resUsers []string
-	users, err := listGroupUsers(ctx, m, id)
+	users, _, err := listGroupUsers(ctx, m, id)
 	if err != nil {
 		return nil, err
 	}
@@ -88,6 +89,58 @@ func removeGroupMembers(ctx context.Context, client *okta.Client, groupId string
 	return nil
 }
 
+// reconcileGroupMembers computes the delta between the desired and the actual members of the group, so only
+// the users which membership has changed are added or removed. Users which aren't desired are only removed
+// when they are managed, unless the reconciliation is authoritative.
+func reconcileGroupMembers(ctx context.Context, m interface{}, groupID string, desired, managed []string, authoritative bool) error {
+	actual, err := listGroupUserIDs(ctx, m, groupID)
+	if err != nil {
+		return fmt.Errorf("failed to list users of group (%s): %v", groupID, err)
+	}
+	toAdd, toRemove := groupMembersDelta(desired, managed, actual, authoritative)
+	return updateGroupMembersInParallel(ctx, m, groupID, toAdd, toRemove)
+}
+
+func groupMembersDelta(desired, managed, actual []string, authoritative bool) (toAdd, toRemove []string) {
+	desiredSet := toStrIndexedMap(&desired)
+	managedSet := toStrIndexedMap(&managed)
+	actualSet := toStrIndexedMap(&actual)
+	for _, id := range desired {
+		if _, ok := (*actualSet)[id]; !ok {
+			toAdd = append(toAdd, id)
+		}
+	}
+	for _, id := range actual {
+		if _, ok := (*desiredSet)[id]; ok {
+			continue
+		}
+		if _, ok := (*managedSet)[id]; ok || authoritative {
+			toRemove = append(toRemove, id)
+		}
+	}
+	return toAdd, toRemove
+}
+
+// updateGroupMembersInParallel adds and removes the users of a group concurrently, which speeds up the
+// membership updates of large groups
+func updateGroupMembersInParallel(ctx context.Context, m interface{}, groupID string, usersToAdd, usersToRemove []string) error {
+	client := getOktaClientFromMetadata(m)
+	var funcs []func() error
+	for _, user := range usersToAdd {
+		users := []string{user}
+		funcs = append(funcs, func() error {
+			return addGroupMembers(ctx, client, groupID, users)
+		})
+	}
+	for _, user := range usersToRemove {
+		users := []string{user}
+		funcs = append(funcs, func() error {
+			return removeGroupMembers(ctx, client, groupID, users)
+		})
+	}
+	return runInParallel(m, fmt.Sprintf("failed to update memberships of group (%s)", groupID), funcs)
+}
+
 // User Primary Key Operations (use when # users < # groups in operations)
 func addUserToGroups(ctx context.Context, client *okta.Client, userId string, groups []string) error {
 	for _, group := range groups {
@@ -0,0 +1,55 @@
+package okta
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGroupMembersDelta(t *testing.T) {
+	tests := []struct {
+		name          string
+		desired       []string
+		managed       []string
+		actual        []string
+		authoritative bool
+		toAdd         []string
+		toRemove      []string
+	}{
+		{
+			name:     "only missing users are added",
+			desired:  []string{"a", "b", "c"},
+			managed:  []string{"a", "b"},
+			actual:   []string{"a", "b", "x"},
+			toAdd:    []string{"c"},
+			toRemove: nil,
+		},
+		{
+			name:     "only managed users are removed",
+			desired:  []string{"a"},
+			managed:  []string{"a", "b", "c"},
+			actual:   []string{"a", "b", "x"},
+			toAdd:    nil,
+			toRemove: []string{"b"},
+		},
+		{
+			name:          "unmanaged users are removed in authoritative mode",
+			desired:       []string{"a"},
+			managed:       []string{"a", "b"},
+			actual:        []string{"a", "b", "x"},
+			authoritative: true,
+			toAdd:         nil,
+			toRemove:      []string{"b", "x"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			toAdd, toRemove := groupMembersDelta(test.desired, test.managed, test.actual, test.authoritative)
+			if !reflect.DeepEqual(toAdd, test.toAdd) {
+				t.Errorf("expected users to add %v, got %v", test.toAdd, toAdd)
+			}
+			if !reflect.DeepEqual(toRemove, test.toRemove) {
+				t.Errorf("expected users to remove %v, got %v", test.toRemove, toRemove)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package okta
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// hookOAuthSchema OAuth 2.0 client credentials authentication of the inline and event hook channels
+var hookOAuthSchema = &schema.Schema{
+	Type:          schema.TypeList,
+	Optional:      true,
+	MaxItems:      1,
+	ConflictsWith: []string{"auth"},
+	Description:   "OAuth 2.0 client credentials authentication of the requests to the hook endpoint, the channel type is OAUTH when it is set",
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"auth_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: elemInSlice([]string{"client_secret_post", "private_key_jwt"}),
+				Description:      "Client authentication method: client_secret_post or private_key_jwt",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Client ID of the app registered in the authorization server",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Client secret of the app, required when auth_type is client_secret_post",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the hook key used to sign the client assertion, required when auth_type is private_key_jwt",
+			},
+			"token_url": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringIsURL("https"),
+				Description:      "Token endpoint of the authorization server",
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Space separated scopes requested for the access token",
+			},
+		},
+	},
+}
+
+// buildHookOAuth builds the OAuth 2.0 config of the hook channel, and returns the type of the channel, which is
+// OAUTH when the 'oauth' is set, or the configured one otherwise
+func buildHookOAuth(d *schema.ResourceData, channelType string) (sdk.HookChannelOAuthConfig, string, error) {
+	oauth, ok := d.GetOk("oauth.0")
+	if !ok {
+		if channelType == sdk.HookChannelTypeOAuth {
+			return sdk.HookChannelOAuthConfig{}, "", fmt.Errorf("'oauth' is required when channel 'type' is '%s'", sdk.HookChannelTypeOAuth)
+		}
+		if channelType == "" {
+			channelType = "HTTP"
+		}
+		return sdk.HookChannelOAuthConfig{}, channelType, nil
+	}
+	if channelType != "" && channelType != sdk.HookChannelTypeOAuth {
+		return sdk.HookChannelOAuthConfig{}, "", fmt.Errorf("channel 'type' must be '%s' when 'oauth' is set", sdk.HookChannelTypeOAuth)
+	}
+	o := oauth.(map[string]interface{})
+	config := sdk.HookChannelOAuthConfig{
+		AuthType:     o["auth_type"].(string),
+		ClientId:     o["client_id"].(string),
+		ClientSecret: o["client_secret"].(string),
+		HookKeyId:    o["key_id"].(string),
+		TokenUrl:     o["token_url"].(string),
+		Scope:        o["scope"].(string),
+	}
+	if config.AuthType == "client_secret_post" && config.ClientSecret == "" {
+		return sdk.HookChannelOAuthConfig{}, "", errors.New("oauth 'client_secret' is required when 'auth_type' is 'client_secret_post'")
+	}
+	if config.AuthType == "private_key_jwt" && config.HookKeyId == "" {
+		return sdk.HookChannelOAuthConfig{}, "", errors.New("oauth 'key_id' is required when 'auth_type' is 'private_key_jwt'")
+	}
+	return config, sdk.HookChannelTypeOAuth, nil
+}
+
+func flattenHookOAuth(d *schema.ResourceData, channelType string, config sdk.HookChannelOAuthConfig) []interface{} {
+	if channelType != sdk.HookChannelTypeOAuth {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"auth_type": config.AuthType,
+			"client_id": config.ClientId,
+			// Read only
+			"client_secret": d.Get("oauth.0.client_secret"),
+			"key_id":        config.HookKeyId,
+			"token_url":     config.TokenUrl,
+			"scope":         config.Scope,
+		},
+	}
+}
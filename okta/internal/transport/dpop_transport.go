@@ -0,0 +1,262 @@
+package transport
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const (
+	dpopHeader          = "DPoP"
+	dpopNonceHeader     = "DPoP-Nonce"
+	useDPoPNonce        = "use_dpop_nonce"
+	clientAssertionType = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+	// tokenExpiryLeeway the access token is renewed a bit before it expires, so it doesn't expire in flight
+	tokenExpiryLeeway = time.Minute
+)
+
+type DPoPTransport struct {
+	base            http.RoundTripper
+	orgURL          *url.URL
+	tokenURL        string
+	clientID        string
+	scopes          []string
+	assertionSigner jose.Signer
+	proofSigner     jose.Signer
+
+	tokenMu     sync.Mutex
+	accessToken string
+	expiry      time.Time
+
+	nonceMu sync.Mutex
+	nonce   string
+}
+
+// NewDPoPTransport returns a transport that authenticates the requests to the org with the DPoP-bound access
+// tokens. The tokens are obtained with the client credentials grant of the OAuth 2.0 service app, which is
+// authenticated with the private key JWT. The DPoP proofs are signed with an ephemeral key.
+func NewDPoPTransport(base http.RoundTripper, orgURL, clientID string, scopes []string, privateKey *rsa.PrivateKey, keyID string) (*DPoPTransport, error) {
+	u, err := url.Parse(orgURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid org URL: %v", err)
+	}
+	var assertionOptions *jose.SignerOptions
+	if keyID != "" {
+		assertionOptions = (&jose.SignerOptions{}).WithHeader("kid", keyID)
+	}
+	assertionSigner, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: privateKey}, assertionOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client assertion signer: %v", err)
+	}
+	proofKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate DPoP key: %v", err)
+	}
+	proofSigner, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: proofKey},
+		(&jose.SignerOptions{EmbedJWK: true}).WithType("dpop+jwt"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create DPoP proof signer: %v", err)
+	}
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &DPoPTransport{
+		base:            base,
+		orgURL:          u,
+		tokenURL:        strings.TrimSuffix(orgURL, "/") + "/oauth2/v1/token",
+		clientID:        clientID,
+		scopes:          scopes,
+		assertionSigner: assertionSigner,
+		proofSigner:     proofSigner,
+	}, nil
+}
+
+// RoundTrip replaces the authorization of the requests to the org with the DPoP-bound access token and its
+// proof, the requests to the other hosts are sent as they are
+func (t *DPoPTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host != t.orgURL.Host {
+		return t.base.RoundTrip(req)
+	}
+	accessToken, err := t.token(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.send(req, accessToken)
+	if err != nil || !t.updateNonce(resp, http.StatusUnauthorized) {
+		return resp, err
+	}
+	// the resource server requires the nonce, so the request is sent again with it, if its body can be rewound
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return resp, nil
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		req = req.Clone(req.Context())
+		req.Body = body
+	}
+	drainBody(resp)
+	return t.send(req, accessToken)
+}
+
+func (t *DPoPTransport) send(req *http.Request, accessToken string) (*http.Response, error) {
+	proof, err := t.proof(req.Method, req.URL, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	r := req.Clone(req.Context())
+	r.Header.Set("Authorization", dpopHeader+" "+accessToken)
+	r.Header.Set(dpopHeader, proof)
+	return t.base.RoundTrip(r)
+}
+
+func (t *DPoPTransport) token(req *http.Request) (string, error) {
+	t.tokenMu.Lock()
+	defer t.tokenMu.Unlock()
+	if t.accessToken != "" && time.Now().Before(t.expiry) {
+		return t.accessToken, nil
+	}
+	resp, err := t.requestToken(req)
+	if err != nil {
+		return "", err
+	}
+	// the authorization server requires the nonce, so the token is requested again with it
+	if t.updateNonce(resp, http.StatusBadRequest) {
+		drainBody(resp)
+		resp, err = t.requestToken(req)
+		if err != nil {
+			return "", err
+		}
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read DPoP access token response: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get DPoP access token: %s: %s", resp.Status, body)
+	}
+	var token struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(body, &token); err != nil {
+		return "", fmt.Errorf("failed to parse DPoP access token response: %v", err)
+	}
+	if !strings.EqualFold(token.TokenType, dpopHeader) {
+		return "", fmt.Errorf("expected access token of the DPoP type, got '%s', DPoP-bound access tokens should be required for the app", token.TokenType)
+	}
+	t.accessToken = token.AccessToken
+	t.expiry = time.Now().Add(time.Duration(token.ExpiresIn)*time.Second - tokenExpiryLeeway)
+	return t.accessToken, nil
+}
+
+func (t *DPoPTransport) requestToken(req *http.Request) (*http.Response, error) {
+	now := time.Now()
+	assertion, err := jwt.Signed(t.assertionSigner).Claims(jwt.Claims{
+		Issuer:   t.clientID,
+		Subject:  t.clientID,
+		Audience: jwt.Audience{t.tokenURL},
+		IssuedAt: jwt.NewNumericDate(now),
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+		ID:       randomID(),
+	}).CompactSerialize()
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign client assertion: %v", err)
+	}
+	tokenURL, _ := url.Parse(t.tokenURL)
+	proof, err := t.proof(http.MethodPost, tokenURL, "")
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{}
+	form.Add("grant_type", "client_credentials")
+	form.Add("scope", strings.Join(t.scopes, " "))
+	form.Add("client_assertion_type", clientAssertionType)
+	form.Add("client_assertion", assertion)
+	r, err := http.NewRequestWithContext(req.Context(), http.MethodPost, t.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Accept", "application/json")
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.Header.Set(dpopHeader, proof)
+	resp, err := t.base.RoundTrip(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DPoP access token: %v", err)
+	}
+	return resp, nil
+}
+
+// proof signs the DPoP proof of the request, the access token hash is only added to the proofs of
+// the resource requests
+func (t *DPoPTransport) proof(method string, u *url.URL, accessToken string) (string, error) {
+	claims := map[string]interface{}{
+		"htm": method,
+		"htu": fmt.Sprintf("%s://%s%s", u.Scheme, u.Host, u.Path),
+		"iat": time.Now().Unix(),
+		"jti": randomID(),
+	}
+	if accessToken != "" {
+		hash := sha256.Sum256([]byte(accessToken))
+		claims["ath"] = base64.RawURLEncoding.EncodeToString(hash[:])
+	}
+	t.nonceMu.Lock()
+	nonce := t.nonce
+	t.nonceMu.Unlock()
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+	proof, err := jwt.Signed(t.proofSigner).Claims(claims).CompactSerialize()
+	if err != nil {
+		return "", fmt.Errorf("failed to sign DPoP proof: %v", err)
+	}
+	return proof, nil
+}
+
+// updateNonce saves the nonce of the response, it returns true if the nonce was required
+// by the server, and the request should be sent again
+func (t *DPoPTransport) updateNonce(resp *http.Response, status int) bool {
+	nonce := resp.Header.Get(dpopNonceHeader)
+	if nonce == "" {
+		return false
+	}
+	t.nonceMu.Lock()
+	t.nonce = nonce
+	t.nonceMu.Unlock()
+	if resp.StatusCode != status {
+		return false
+	}
+	if status == http.StatusUnauthorized {
+		return strings.Contains(resp.Header.Get("WWW-Authenticate"), useDPoPNonce)
+	}
+	return true
+}
+
+func drainBody(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+}
+
+func randomID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
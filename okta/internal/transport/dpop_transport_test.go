@@ -0,0 +1,111 @@
+package transport
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+func verifyDPoPProof(t *testing.T, r *http.Request) map[string]interface{} {
+	t.Helper()
+	proof, err := jwt.ParseSigned(r.Header.Get("DPoP"))
+	if err != nil {
+		t.Fatalf("failed to parse DPoP proof: %v", err)
+	}
+	header := proof.Headers[0]
+	if header.ExtraHeaders["typ"] != "dpop+jwt" {
+		t.Errorf("unexpected DPoP proof type: %v", header.ExtraHeaders["typ"])
+	}
+	if header.JSONWebKey == nil {
+		t.Fatal("DPoP proof doesn't embed the JWK")
+	}
+	claims := map[string]interface{}{}
+	if err := proof.Claims(header.JSONWebKey.Key, &claims); err != nil {
+		t.Fatalf("failed to verify DPoP proof: %v", err)
+	}
+	if claims["htm"] != r.Method {
+		t.Errorf("expected htm %s, got %v", r.Method, claims["htm"])
+	}
+	if htu := fmt.Sprintf("http://%s%s", r.Host, r.URL.Path); claims["htu"] != htu {
+		t.Errorf("expected htu %s, got %v", htu, claims["htu"])
+	}
+	if claims["jti"] == "" {
+		t.Error("DPoP proof doesn't have the jti")
+	}
+	return claims
+}
+
+func TestDPoPTransport(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tokenRequests, apiRequests int
+	org := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims := verifyDPoPProof(t, r)
+		switch r.URL.Path {
+		case "/oauth2/v1/token":
+			tokenRequests++
+			if claims["nonce"] != "server-nonce" {
+				w.Header().Set("DPoP-Nonce", "server-nonce")
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"use_dpop_nonce"}`))
+				return
+			}
+			if r.FormValue("client_assertion_type") != clientAssertionType || r.FormValue("scope") != "okta.users.read okta.groups.read" {
+				t.Errorf("unexpected token request: %v", r.Form)
+			}
+			_, _ = w.Write([]byte(`{"access_token":"token","token_type":"DPoP","expires_in":3600}`))
+		default:
+			apiRequests++
+			if r.Header.Get("Authorization") != "DPoP token" {
+				t.Errorf("unexpected authorization: %s", r.Header.Get("Authorization"))
+			}
+			hash := sha256.Sum256([]byte("token"))
+			if claims["ath"] != base64.RawURLEncoding.EncodeToString(hash[:]) {
+				t.Errorf("unexpected access token hash: %v", claims["ath"])
+			}
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer org.Close()
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("DPoP") != "" || strings.HasPrefix(r.Header.Get("Authorization"), "DPoP") {
+			t.Error("DPoP should only be used for the requests to the org")
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer other.Close()
+
+	transport, err := NewDPoPTransport(nil, org.URL, "clientID", []string{"okta.users.read", "okta.groups.read"}, key, "key-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: transport}
+	for _, url := range []string{org.URL + "/api/v1/users", org.URL + "/api/v1/groups", other.URL + "/metadata"} {
+		req, _ := http.NewRequest(http.MethodGet, url, nil)
+		req.Header.Set("Authorization", "Bearer DPoP")
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("unexpected status of %s: %d", url, resp.StatusCode)
+		}
+	}
+	if tokenRequests != 2 {
+		t.Errorf("expected the token to be requested again with the nonce only, got %d token requests", tokenRequests)
+	}
+	if apiRequests != 2 {
+		t.Errorf("expected 2 API requests, got %d", apiRequests)
+	}
+}
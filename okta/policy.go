@@ -26,7 +26,7 @@ var (
 		"priority": {
 			Type:        schema.TypeInt,
 			Optional:    true,
-			Description: "Policy Priority, this attribute can be set to a valid priority. To avoid endless diff situation we error if an invalid priority is provided. API defaults it to the last (lowest) if not there.",
+			Description: "Policy Priority, this attribute can be set to a valid priority. API defaults it to the last (lowest) if not there. The configured priority is applied again once there are enough sibling policies, so the policies created in any order end up in the configured order. To avoid endless diff situation we error on update if an invalid priority is provided. Omit it to let the API manage it.",
 			// Suppress diff if config is empty.
 			DiffSuppressFunc: createValueDiffSuppression("0"),
 		},
@@ -45,6 +45,12 @@ var (
 				Type: schema.TypeString,
 			},
 		},
+		"deactivate_on_destroy": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Deactivate the policy on destroy instead of deleting it, e.g. when it's still used outside of Terraform",
+		},
 	}
 
 	defaultPolicySchema = map[string]*schema.Schema{
@@ -85,8 +91,8 @@ var (
 	isOieSchema = &schema.Schema{
 		Type:        schema.TypeBool,
 		Optional:    true,
-		Default:     false,
-		Description: "Is the policy using Okta Identity Engine (OIE) with authenticators instead of factors?",
+		Computed:    true,
+		Description: "Is the policy using Okta Identity Engine (OIE) with authenticators instead of factors? Defaults to the engine of the org for the new policies",
 	}
 )
 
@@ -134,22 +140,41 @@ func buildMfaPolicySchema(target map[string]*schema.Schema) map[string]*schema.S
 	return schema
 }
 
+// createPolicyImporter imports the policy only when it is of the expected type, since all the policies share the same API
+func createPolicyImporter(policyType string) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			policy, _, err := getSupplementFromMetadata(m).GetPolicy(ctx, d.Id())
+			if err != nil {
+				return nil, fmt.Errorf("failed to get policy: %v", err)
+			}
+			if policy.Type != policyType {
+				return nil, fmt.Errorf("policy '%s' is of the '%s' type, expected '%s'", d.Id(), policy.Type, policyType)
+			}
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
 func createPolicy(ctx context.Context, d *schema.ResourceData, m interface{}, template sdk.Policy) error {
 	logger(m).Info("creating policy", "name", template.Name, "type", template.Type)
 	if err := ensureNotDefaultPolicy(d); err != nil {
 		return err
 	}
+	// policies of the same type are changed one at a time, since the API shifts the priorities of the siblings
+	oktaMutexKV.Lock(template.Type)
+	defer oktaMutexKV.Unlock(template.Type)
 	policy, _, err := getSupplementFromMetadata(m).CreatePolicy(ctx, template)
 	if err != nil {
 		return err
 	}
 	d.SetId(policy.Id)
-	// Even if priority is invalid we want to add the policy to Terraform to reflect upstream.
-	err = validatePriority(template.Priority, policy.Priority)
+	// the API puts the policy last when there are not enough siblings for the configured priority yet,
+	// so it's applied again once they are created
+	err = reconcilePolicyPriority(ctx, m, policy.Id, template)
 	if err != nil {
 		return err
 	}
-
 	return policyActivate(ctx, d, m)
 }
 
@@ -208,6 +233,8 @@ func updatePolicy(ctx context.Context, d *schema.ResourceData, m interface{}, te
 	if err := ensureNotDefaultPolicy(d); err != nil {
 		return err
 	}
+	oktaMutexKV.Lock(template.Type)
+	defer oktaMutexKV.Unlock(template.Type)
 	policy, _, err := getSupplementFromMetadata(m).UpdatePolicy(ctx, d.Id(), template)
 	if err != nil {
 		return err
@@ -217,6 +244,10 @@ func updatePolicy(ctx context.Context, d *schema.ResourceData, m interface{}, te
 	if err != nil {
 		return err
 	}
+	err = reconcilePolicyPriority(ctx, m, d.Id(), template)
+	if err != nil {
+		return err
+	}
 	return policyActivate(ctx, d, m)
 }
 
@@ -224,10 +255,20 @@ func deletePolicy(ctx context.Context, d *schema.ResourceData, m interface{}) er
 	if err := ensureNotDefaultPolicy(d); err != nil {
 		return err
 	}
-	logger(m).Info("deleting policy", "id", d.Id())
+	priorities.forget(d.Id())
 	client := getOktaClientFromMetadata(m)
-	_, err := client.Policy.DeletePolicy(ctx, d.Id())
-	if err != nil {
+	if deactivate, _ := d.Get("deactivate_on_destroy").(bool); deactivate {
+		logger(m).Info("deactivating policy instead of deleting it", "id", d.Id())
+		resp, err := client.Policy.DeactivatePolicy(ctx, d.Id())
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return fmt.Errorf("deactivation has failed: %v", err)
+		}
+		d.SetId("")
+		return nil
+	}
+	logger(m).Info("deleting policy", "id", d.Id())
+	resp, err := client.Policy.DeletePolicy(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
 		return err
 	}
 	// remove the policy resource from terraform
@@ -240,15 +281,14 @@ func syncPolicyFromUpstream(d *schema.ResourceData, policy *sdk.Policy) error {
 	_ = d.Set("description", policy.Description)
 	_ = d.Set("status", policy.Status)
 	_ = d.Set("priority", policy.Priority)
+	var groups []string
 	if policy.Conditions != nil &&
 		policy.Conditions.People != nil &&
-		policy.Conditions.People.Groups != nil &&
-		policy.Conditions.People.Groups.Include != nil {
-		return setNonPrimitives(d, map[string]interface{}{
-			"groups_included": convertStringSliceToSet(policy.Conditions.People.Groups.Include),
-		})
+		policy.Conditions.People.Groups != nil {
+		groups = policy.Conditions.People.Groups.Include
 	}
-	return nil
+	// groups removed outside of Terraform should be shown as drift
+	return d.Set("groups_included", convertStringSliceToSet(groups))
 }
 
 func findDefaultAccessPolicy(ctx context.Context, m interface{}) (*okta.Policy, error) {
@@ -268,18 +308,29 @@ func findDefaultAccessPolicy(ctx context.Context, m interface{}) (*okta.Policy,
 func findSystemPolicyByType(ctx context.Context, m interface{}, _type string) (*okta.Policy, error) {
 	client := getOktaClientFromMetadata(m)
 	qp := query.NewQueryParams(query.WithType(_type))
-	policies, _, err := client.Policy.ListPolicies(ctx, qp)
+	policies, resp, err := client.Policy.ListPolicies(ctx, qp)
 	if err != nil {
 		return nil, err
 	}
-
 	for _, p := range policies {
 		policy := p.(*okta.Policy)
-		if *policy.System {
+		if policy.System != nil && *policy.System {
 			return policy, nil
 		}
 	}
-
+	// the next pages can't be decoded into the slice of the interfaces
+	for resp.HasNextPage() {
+		var nextPolicies []*okta.Policy
+		resp, err = resp.Next(ctx, &nextPolicies)
+		if err != nil {
+			return nil, err
+		}
+		for _, policy := range nextPolicies {
+			if policy.System != nil && *policy.System {
+				return policy, nil
+			}
+		}
+	}
 	return nil, fmt.Errorf("default system %q policy not found", _type)
 }
 
@@ -13,6 +13,9 @@ import (
 	"github.com/okta/terraform-provider-okta/sdk"
 )
 
+// defaultRuleName name of the rule every policy ends with
+const defaultRuleName = "Default Rule"
+
 var (
 	userExcludedSchema = map[string]*schema.Schema{
 		"users_excluded": {
@@ -47,7 +50,7 @@ var (
 		"priority": {
 			Type:        schema.TypeInt,
 			Optional:    true,
-			Description: "Policy Rule Priority, this attribute can be set to a valid priority. To avoid endless diff situation we error if an invalid priority is provided. API defaults it to the last (lowest) if not there.",
+			Description: "Policy Rule Priority, this attribute can be set to a valid priority. API defaults it to the last (lowest) if not there. The configured priority is applied again once there are enough sibling rules, so the rules created in any order end up in the configured order. To avoid endless diff situation we error on update if an invalid priority is provided. Omit it to let the API manage it.",
 			// Suppress diff if config is empty.
 			DiffSuppressFunc: createValueDiffSuppression("0"),
 		},
@@ -110,8 +113,7 @@ func buildRuleSchema(target map[string]*schema.Schema) map[string]*schema.Schema
 
 func createRule(ctx context.Context, d *schema.ResourceData, m interface{}, template sdk.PolicyRule, ruleType string) error {
 	logger(m).Info("creating policy rule", "name", d.Get("name").(string))
-	err := ensureNotDefaultRule(d)
-	if err != nil {
+	if err := validatePolicyNetworkCondition(d); err != nil {
 		return err
 	}
 	policyID := d.Get("policy_id").(string)
@@ -121,8 +123,14 @@ func createRule(ctx context.Context, d *schema.ResourceData, m interface{}, temp
 	if policyID == "" {
 		return fmt.Errorf("either 'policyid' or 'policy_id' field should be set")
 	}
+	// rules of the same policy are changed one at a time, since the API shifts the priorities of the siblings
+	oktaMutexKV.Lock(policyID)
+	defer oktaMutexKV.Unlock(policyID)
+	if isDefaultRule(d) {
+		return adoptDefaultRule(ctx, d, m, policyID, template)
+	}
 	var rule *sdk.PolicyRule
-	err = backoff.Retry(func() error {
+	err := backoff.Retry(func() error {
 		ruleObj, resp, err := getSupplementFromMetadata(m).CreatePolicyRule(ctx, policyID, template)
 		if resp.StatusCode == http.StatusInternalServerError {
 			return err
@@ -143,9 +151,10 @@ func createRule(ctx context.Context, d *schema.ResourceData, m interface{}, temp
 			return fmt.Errorf("failed to deactivate policy rule on creation: %v", err)
 		}
 	}
-	// We want to put this under Terraform's control even if priority is invalid.
 	d.SetId(rule.Id)
-	return validatePriority(template.Priority, rule.Priority)
+	// the API puts the rule last when there are not enough siblings for the configured priority yet,
+	// so it's applied again once they are created
+	return reconcilePolicyRulePriority(ctx, m, policyID, rule.Id, template)
 }
 
 func createPolicyRuleImporter() *schema.ResourceImporter {
@@ -162,8 +171,83 @@ func createPolicyRuleImporter() *schema.ResourceImporter {
 	}
 }
 
-func ensureNotDefaultRule(d *schema.ResourceData) error {
-	return ensureNotDefault(d, "Rule")
+// isDefaultRule the default rule of a policy can't be created nor deleted, so it's adopted on creation
+// and reset to the defaults on deletion
+func isDefaultRule(d *schema.ResourceData) bool {
+	return d.Get("name").(string) == defaultRuleName
+}
+
+// validateDefaultRuleDiff the priority and status of the default rule are managed by the API, since it's always
+// the last active rule of the policy, and the rule can't be renamed to or from the default rule, since the
+// resource would point to a different rule
+func validateDefaultRuleDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() != "" && d.HasChange("name") {
+		oldName, newName := d.GetChange("name")
+		if oldName.(string) == defaultRuleName || newName.(string) == defaultRuleName {
+			return fmt.Errorf("rule can't be renamed from '%s' to '%s', %s is a different rule of the policy", oldName, newName, defaultRuleName)
+		}
+	}
+	if d.Get("name").(string) != defaultRuleName {
+		return nil
+	}
+	raw := d.GetRawConfig()
+	if raw.IsNull() {
+		return nil
+	}
+	for _, k := range []string{"priority", "status"} {
+		if !raw.GetAttr(k).IsNull() {
+			return fmt.Errorf("%s is immutable, its '%s' can't be configured", defaultRuleName, k)
+		}
+	}
+	return nil
+}
+
+// defaultRuleTemplate builds the rule with the defaults of the resource schema, which the default rule is
+// reset to on deletion
+func defaultRuleTemplate(r *schema.Resource, d *schema.ResourceData, build func(d *schema.ResourceData) sdk.PolicyRule) (sdk.PolicyRule, error) {
+	defaults := r.TestResourceData()
+	for k, s := range r.Schema {
+		if s.Default == nil {
+			continue
+		}
+		if err := defaults.Set(k, s.Default); err != nil {
+			return sdk.PolicyRule{}, fmt.Errorf("failed to set default of '%s': %v", k, err)
+		}
+	}
+	_ = defaults.Set("name", defaultRuleName)
+	_ = defaults.Set("policy_id", d.Get("policy_id"))
+	_ = defaults.Set("policyid", d.Get("policyid"))
+	return build(defaults), nil
+}
+
+// adoptDefaultRule points the resource to the system rule of the policy, and updates it
+func adoptDefaultRule(ctx context.Context, d *schema.ResourceData, m interface{}, policyID string, template sdk.PolicyRule) error {
+	rules, _, err := getSupplementFromMetadata(m).ListPolicyRules(ctx, policyID)
+	if err != nil {
+		return fmt.Errorf("failed to list rules of policy '%s': %v", policyID, err)
+	}
+	for _, rule := range rules {
+		if rule.System == nil || !*rule.System {
+			continue
+		}
+		if err := updateDefaultRule(ctx, m, policyID, &rule, template); err != nil {
+			return err
+		}
+		d.SetId(rule.Id)
+		return nil
+	}
+	return fmt.Errorf("policy '%s' has no '%s'", policyID, defaultRuleName)
+}
+
+// updateDefaultRule the default rule is always the last active one, so its priority and status are kept
+func updateDefaultRule(ctx context.Context, m interface{}, policyID string, rule *sdk.PolicyRule, template sdk.PolicyRule) error {
+	template.Priority = rule.Priority
+	template.Status = rule.Status
+	_, _, err := getSupplementFromMetadata(m).UpdatePolicyRule(ctx, policyID, rule.Id, template)
+	if err != nil {
+		return fmt.Errorf("failed to update default rule of policy '%s': %v", policyID, err)
+	}
+	return nil
 }
 
 func buildPolicyNetworkCondition(d *schema.ResourceData) *okta.PolicyNetworkCondition {
@@ -174,6 +258,31 @@ func buildPolicyNetworkCondition(d *schema.ResourceData) *okta.PolicyNetworkCond
 	}
 }
 
+// validatePolicyNetworkCondition the zones are only honored by the API when the connection is ZONE,
+// otherwise they would be silently dropped and cause a diff on the next plan
+func validatePolicyNetworkCondition(d *schema.ResourceData) error {
+	connection := d.Get("network_connection").(string)
+	hasZones := len(d.Get("network_includes").([]interface{})) > 0 || len(d.Get("network_excludes").([]interface{})) > 0
+	if connection != "ZONE" && hasZones {
+		return fmt.Errorf("'network_includes' and 'network_excludes' can only be set when 'network_connection' is 'ZONE'")
+	}
+	if connection == "ZONE" && !hasZones {
+		return fmt.Errorf("either 'network_includes' or 'network_excludes' should be set when 'network_connection' is 'ZONE'")
+	}
+	return nil
+}
+
+func syncPolicyNetworkCondition(d *schema.ResourceData, network *okta.PolicyNetworkCondition) error {
+	if network == nil {
+		network = &okta.PolicyNetworkCondition{Connection: "ANYWHERE"}
+	}
+	_ = d.Set("network_connection", network.Connection)
+	return setNonPrimitives(d, map[string]interface{}{
+		"network_includes": convertStringSliceToInterfaceSlice(network.Include),
+		"network_excludes": convertStringSliceToInterfaceSlice(network.Exclude),
+	})
+}
+
 func getPolicyRule(ctx context.Context, d *schema.ResourceData, m interface{}) (*sdk.PolicyRule, error) {
 	client := getSupplementFromMetadata(m)
 	policyID := d.Get("policy_id").(string)
@@ -220,27 +329,24 @@ func syncRuleFromUpstream(d *schema.ResourceData, rule *sdk.PolicyRule) error {
 	_ = d.Set("name", rule.Name)
 	_ = d.Set("status", rule.Status)
 	_ = d.Set("priority", rule.Priority)
-	_ = d.Set("network_connection", rule.Conditions.Network.Connection)
-	m := map[string]interface{}{
-		"users_excluded": convertStringSliceToSetNullable(rule.Conditions.People.Users.Exclude),
+	if rule.Conditions == nil {
+		return syncPolicyNetworkCondition(d, nil)
 	}
-	if len(rule.Conditions.Network.Include) > 0 {
-		m["network_includes"] = convertStringSliceToInterfaceSlice(rule.Conditions.Network.Include)
-	}
-	if len(rule.Conditions.Network.Exclude) > 0 {
-		m["network_excludes"] = convertStringSliceToInterfaceSlice(rule.Conditions.Network.Exclude)
+	if err := syncPolicyNetworkCondition(d, rule.Conditions.Network); err != nil {
+		return err
 	}
-	if rule.Conditions.Network.Connection != "ANYWHERE" {
-		return setNonPrimitives(d, m)
+	var usersExcluded []string
+	if rule.Conditions.People != nil && rule.Conditions.People.Users != nil {
+		usersExcluded = rule.Conditions.People.Users.Exclude
 	}
 	return setNonPrimitives(d, map[string]interface{}{
-		"users_excluded": convertStringSliceToSetNullable(rule.Conditions.People.Users.Exclude),
+		"users_excluded": convertStringSliceToSetNullable(usersExcluded),
 	})
 }
 
 func updateRule(ctx context.Context, d *schema.ResourceData, m interface{}, template sdk.PolicyRule) error {
 	logger(m).Info("updating policy rule", "name", d.Get("name").(string))
-	if err := ensureNotDefaultRule(d); err != nil {
+	if err := validatePolicyNetworkCondition(d); err != nil {
 		return err
 	}
 	policyID := d.Get("policy_id").(string)
@@ -250,6 +356,15 @@ func updateRule(ctx context.Context, d *schema.ResourceData, m interface{}, temp
 	if policyID == "" {
 		return fmt.Errorf("either 'policyid' or 'policy_id' field should be set")
 	}
+	oktaMutexKV.Lock(policyID)
+	defer oktaMutexKV.Unlock(policyID)
+	if isDefaultRule(d) {
+		rule, _, err := getSupplementFromMetadata(m).GetPolicyRule(ctx, policyID, d.Id())
+		if err != nil {
+			return fmt.Errorf("failed to get default rule of policy '%s': %v", policyID, err)
+		}
+		return updateDefaultRule(ctx, m, policyID, rule, template)
+	}
 	rule, _, err := getSupplementFromMetadata(m).UpdatePolicyRule(ctx, policyID, d.Id(), template)
 	if err != nil {
 		return err
@@ -258,6 +373,10 @@ func updateRule(ctx context.Context, d *schema.ResourceData, m interface{}, temp
 	if err != nil {
 		return err
 	}
+	err = reconcilePolicyRulePriority(ctx, m, policyID, d.Id(), template)
+	if err != nil {
+		return err
+	}
 	return policyRuleActivate(ctx, d, m)
 }
 
@@ -286,11 +405,12 @@ func policyRuleActivate(ctx context.Context, d *schema.ResourceData, m interface
 	return nil
 }
 
-func deleteRule(ctx context.Context, d *schema.ResourceData, m interface{}, checkIsSystemPolicy bool) error {
+func deleteRule(ctx context.Context, d *schema.ResourceData, m interface{}, checkIsSystemPolicy bool, defaults func() (sdk.PolicyRule, error)) error {
 	logger(m).Info("deleting policy rule", "name", d.Get("name").(string))
-	if err := ensureNotDefaultRule(d); err != nil {
-		return err
+	if isDefaultRule(d) {
+		return resetDefaultRule(ctx, d, m, defaults)
 	}
+	priorities.forget(d.Id())
 	rule, err := getPolicyRule(ctx, d, m)
 	if err != nil {
 		return err
@@ -313,6 +433,8 @@ func deleteRule(ctx context.Context, d *schema.ResourceData, m interface{}, chec
 		if policyID == "" {
 			return fmt.Errorf("either 'policyid' or 'policy_id' field should be set")
 		}
+		oktaMutexKV.Lock(policyID)
+		defer oktaMutexKV.Unlock(policyID)
 		_, err = getOktaClientFromMetadata(m).Policy.DeletePolicyRule(ctx, policyID, d.Id())
 		if err != nil {
 			return err
@@ -320,3 +442,26 @@ func deleteRule(ctx context.Context, d *schema.ResourceData, m interface{}, chec
 	}
 	return nil
 }
+
+// resetDefaultRule the default rule of a policy can't be deleted, so it's reset to the defaults instead
+func resetDefaultRule(ctx context.Context, d *schema.ResourceData, m interface{}, defaults func() (sdk.PolicyRule, error)) error {
+	logger(m).Info(fmt.Sprintf("Policy Rule '%s' can't be deleted, it's reset to the defaults", defaultRuleName))
+	rule, err := getPolicyRule(ctx, d, m)
+	if err != nil {
+		return err
+	}
+	if rule == nil {
+		return nil
+	}
+	template, err := defaults()
+	if err != nil {
+		return err
+	}
+	policyID := d.Get("policy_id").(string)
+	if policyID == "" {
+		policyID = d.Get("policyid").(string)
+	}
+	oktaMutexKV.Lock(policyID)
+	defer oktaMutexKV.Unlock(policyID)
+	return updateDefaultRule(ctx, m, policyID, rule, template)
+}
@@ -0,0 +1,193 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+// priorityReconciler keeps track of the priorities configured for the policies and rules changed by the provider.
+// The API puts a policy or rule last when there are not enough siblings for its priority yet, and shifts the
+// priorities of the siblings when one of them is inserted or moved, so the configured priorities are applied
+// again once the siblings are created.
+type priorityReconciler struct {
+	mu      sync.Mutex
+	entries map[string]map[string]*priorityEntry
+}
+
+type priorityEntry struct {
+	priority int64
+	// update applies the configured priority, it returns the priority the API responded with
+	update func(ctx context.Context) (int64, error)
+}
+
+// listPriorities returns the actual priorities of the siblings by their IDs
+type listPriorities func(ctx context.Context) (map[string]int64, error)
+
+var priorities = &priorityReconciler{entries: make(map[string]map[string]*priorityEntry)}
+
+// track adds the policy or rule with the configured priority to its parent, which is either the policy type
+// or the policy ID
+func (r *priorityReconciler) track(parent, id string, priority int64, update func(ctx context.Context) (int64, error)) {
+	if priority <= 0 {
+		r.untrack(parent, id)
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.entries[parent] == nil {
+		r.entries[parent] = make(map[string]*priorityEntry)
+	}
+	r.entries[parent][id] = &priorityEntry{priority: priority, update: update}
+}
+
+func (r *priorityReconciler) untrack(parent, id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.entries[parent], id)
+}
+
+// forget stops tracking the policy or rule, which is either deleted or no longer managed
+func (r *priorityReconciler) forget(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, entries := range r.entries {
+		delete(entries, id)
+	}
+}
+
+// reconcile applies the configured priorities of the tracked siblings of the parent in the ascending order,
+// until all of them match or the remaining ones can't be applied yet, since there are not enough siblings.
+// The caller is expected to hold the lock of the parent.
+func (r *priorityReconciler) reconcile(ctx context.Context, parent string, list listPriorities) error {
+	r.mu.Lock()
+	ids := make([]string, 0, len(r.entries[parent]))
+	entries := make(map[string]*priorityEntry, len(r.entries[parent]))
+	for id, entry := range r.entries[parent] {
+		ids = append(ids, id)
+		entries[id] = entry
+	}
+	r.mu.Unlock()
+	if len(ids) == 0 {
+		return nil
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return entries[ids[i]].priority < entries[ids[j]].priority
+	})
+	skipped := make(map[string]bool)
+	// moving one sibling can shift the others, so the priorities are listed again after each update
+	for attempt := 0; attempt < 2*len(ids); attempt++ {
+		actual, err := list(ctx)
+		if err != nil {
+			return err
+		}
+		next := ""
+		for _, id := range ids {
+			p, ok := actual[id]
+			if !ok {
+				// deleted outside of the provider
+				r.untrack(parent, id)
+				continue
+			}
+			if p != entries[id].priority && !skipped[id] {
+				next = id
+				break
+			}
+		}
+		if next == "" {
+			return nil
+		}
+		p, err := entries[next].update(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to apply priority %d: %v", entries[next].priority, err)
+		}
+		if p != entries[next].priority {
+			skipped[next] = true
+		}
+	}
+	return nil
+}
+
+func listPolicyPriorities(m interface{}, policyType string) listPriorities {
+	return func(ctx context.Context) (map[string]int64, error) {
+		policies, resp, err := getOktaClientFromMetadata(m).Policy.ListPolicies(ctx, &query.Params{Type: policyType})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list policies: %v", err)
+		}
+		actual := make(map[string]int64)
+		for _, p := range policies {
+			policy := p.(*okta.Policy)
+			actual[policy.Id] = policy.Priority
+		}
+		// the next pages can't be decoded into the slice of the interfaces
+		for resp.HasNextPage() {
+			var nextPolicies []*okta.Policy
+			resp, err = resp.Next(ctx, &nextPolicies)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list policies: %v", err)
+			}
+			for _, policy := range nextPolicies {
+				actual[policy.Id] = policy.Priority
+			}
+		}
+		return actual, nil
+	}
+}
+
+func listPolicyRulePriorities(m interface{}, policyID string) listPriorities {
+	return func(ctx context.Context) (map[string]int64, error) {
+		rules, _, err := getSupplementFromMetadata(m).ListPolicyRules(ctx, policyID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list rules of policy '%s': %v", policyID, err)
+		}
+		actual := make(map[string]int64, len(rules))
+		for _, rule := range rules {
+			actual[rule.Id] = rule.Priority
+		}
+		return actual, nil
+	}
+}
+
+// reconcilePolicyPriority tracks the configured priority of the policy, and applies the configured priorities
+// of its siblings of the same type
+func reconcilePolicyPriority(ctx context.Context, m interface{}, policyID string, template sdk.Policy) error {
+	priorities.track(template.Type, policyID, template.Priority, func(ctx context.Context) (int64, error) {
+		policy, _, err := getSupplementFromMetadata(m).UpdatePolicy(ctx, policyID, template)
+		if err != nil {
+			return 0, err
+		}
+		return policy.Priority, nil
+	})
+	return priorities.reconcile(ctx, template.Type, listPolicyPriorities(m, template.Type))
+}
+
+// reconcilePolicyRulePriority tracks the configured priority of the rule, and applies the configured priorities
+// of its siblings of the same policy
+func reconcilePolicyRulePriority(ctx context.Context, m interface{}, policyID, ruleID string, template sdk.PolicyRule) error {
+	priorities.track(policyID, ruleID, template.Priority, func(ctx context.Context) (int64, error) {
+		rule, _, err := getSupplementFromMetadata(m).UpdatePolicyRule(ctx, policyID, ruleID, template)
+		if err != nil {
+			return 0, err
+		}
+		return rule.Priority, nil
+	})
+	return priorities.reconcile(ctx, policyID, listPolicyRulePriorities(m, policyID))
+}
+
+// reconcileAppSignOnPolicyRulePriority tracks the configured priority of the app sign-on policy rule, and applies
+// the configured priorities of its siblings of the same policy
+func reconcileAppSignOnPolicyRulePriority(ctx context.Context, m interface{}, policyID, ruleID string, template sdk.AccessPolicyRule) error {
+	priorities.track(policyID, ruleID, template.Priority, func(ctx context.Context) (int64, error) {
+		rule, _, err := getSupplementFromMetadata(m).UpdateAppSignOnPolicyRule(ctx, policyID, ruleID, template)
+		if err != nil {
+			return 0, err
+		}
+		return rule.Priority, nil
+	})
+	return priorities.reconcile(ctx, policyID, listPolicyRulePriorities(m, policyID))
+}
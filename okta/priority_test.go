@@ -0,0 +1,71 @@
+package okta
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+// fakeSiblings mimics the API, which puts a sibling last when there are not enough siblings for its priority
+type fakeSiblings struct {
+	order []string
+}
+
+func (f *fakeSiblings) put(id string, priority int64) int64 {
+	for i, o := range f.order {
+		if o == id {
+			f.order = append(f.order[:i], f.order[i+1:]...)
+			break
+		}
+	}
+	i := int(priority) - 1
+	if i > len(f.order) {
+		i = len(f.order)
+	}
+	f.order = append(f.order[:i], append([]string{id}, f.order[i:]...)...)
+	return int64(i + 1)
+}
+
+func (f *fakeSiblings) list(_ context.Context) (map[string]int64, error) {
+	actual := make(map[string]int64, len(f.order))
+	for i, id := range f.order {
+		actual[id] = int64(i + 1)
+	}
+	return actual, nil
+}
+
+func TestPriorityReconcile(t *testing.T) {
+	ctx := context.Background()
+	r := &priorityReconciler{entries: make(map[string]map[string]*priorityEntry)}
+	siblings := &fakeSiblings{}
+	// the siblings are created in the reverse order of their priorities
+	for _, s := range []struct {
+		id       string
+		priority int64
+	}{{"c", 3}, {"b", 2}, {"a", 1}} {
+		id, priority := s.id, s.priority
+		siblings.put(id, priority)
+		r.track("policy", id, priority, func(_ context.Context) (int64, error) {
+			return siblings.put(id, priority), nil
+		})
+		if err := r.reconcile(ctx, "policy", siblings.list); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if expected := []string{"a", "b", "c"}; !reflect.DeepEqual(siblings.order, expected) {
+		t.Errorf("expected %v order, got %v", expected, siblings.order)
+	}
+
+	// the sibling deleted outside of the provider is no longer tracked
+	siblings.order = []string{"a", "c"}
+	if err := r.reconcile(ctx, "policy", siblings.list); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := r.entries["policy"]["b"]; ok {
+		t.Error("expected deleted sibling to be untracked")
+	}
+	r.forget("a")
+	if _, ok := r.entries["policy"]["a"]; ok {
+		t.Error("expected forgotten sibling to be untracked")
+	}
+}
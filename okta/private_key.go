@@ -0,0 +1,121 @@
+package okta
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+)
+
+type privateJWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	D   string `json:"d"`
+	P   string `json:"p"`
+	Q   string `json:"q"`
+}
+
+// normalizePrivateKey converts the private key of the OAuth 2.0 service app to the PKCS#1 PEM, which is the only
+// format supported by okta-sdk-golang. The key can be a PKCS#1 or PKCS#8 PEM, a JWK, or a JWKS, either inline or
+// in a file. The key of the JWKS is selected by the key ID, unless the JWKS has a single key. The key ID of the JWK
+// is returned along with the key.
+func normalizePrivateKey(privateKey, keyID string) (string, string, error) {
+	if content, err := os.ReadFile(privateKey); err == nil {
+		privateKey = string(content)
+	}
+	privateKey = strings.TrimSpace(strings.ReplaceAll(privateKey, `\n`, "\n"))
+	if strings.HasPrefix(privateKey, "{") {
+		return privateJWKToPEM(privateKey, keyID)
+	}
+	block, _ := pem.Decode([]byte(privateKey))
+	if block == nil {
+		return "", "", errors.New("private key should be either a PEM encoded key or a JWK")
+	}
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		if _, err := x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+			return "", "", fmt.Errorf("failed to parse PKCS#1 private key: %v", err)
+		}
+		return privateKey, "", nil
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to parse PKCS#8 private key: %v", err)
+		}
+		rsaKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return "", "", errors.New("only RSA private keys are supported")
+		}
+		return encodePKCS1PrivateKey(rsaKey), "", nil
+	}
+	return "", "", fmt.Errorf("unsupported private key type '%s'", block.Type)
+}
+
+func privateJWKToPEM(privateKey, keyID string) (string, string, error) {
+	var jwks struct {
+		Keys []*privateJWK `json:"keys"`
+		privateJWK
+	}
+	if err := json.Unmarshal([]byte(privateKey), &jwks); err != nil {
+		return "", "", fmt.Errorf("failed to parse JWK: %v", err)
+	}
+	jwk := &jwks.privateJWK
+	if jwks.Keys != nil {
+		var err error
+		jwk, err = selectPrivateJWK(jwks.Keys, keyID)
+		if err != nil {
+			return "", "", err
+		}
+	}
+	if jwk.Kty != "RSA" {
+		return "", "", errors.New("only RSA private keys are supported")
+	}
+	if jwk.D == "" || jwk.P == "" || jwk.Q == "" {
+		return "", "", errors.New("JWK doesn't contain the private key")
+	}
+	ints := make([]*big.Int, 5)
+	for i, v := range []string{jwk.N, jwk.E, jwk.D, jwk.P, jwk.Q} {
+		b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(v, "="))
+		if err != nil {
+			return "", "", fmt.Errorf("failed to decode JWK: %v", err)
+		}
+		ints[i] = new(big.Int).SetBytes(b)
+	}
+	key := &rsa.PrivateKey{
+		PublicKey: rsa.PublicKey{N: ints[0], E: int(ints[1].Int64())},
+		D:         ints[2],
+		Primes:    []*big.Int{ints[3], ints[4]},
+	}
+	if err := key.Validate(); err != nil {
+		return "", "", fmt.Errorf("invalid private key: %v", err)
+	}
+	key.Precompute()
+	return encodePKCS1PrivateKey(key), jwk.Kid, nil
+}
+
+func selectPrivateJWK(keys []*privateJWK, keyID string) (*privateJWK, error) {
+	if keyID == "" {
+		if len(keys) != 1 {
+			return nil, fmt.Errorf("'private_key_id' is required to select the key of the JWKS with %d keys", len(keys))
+		}
+		return keys[0], nil
+	}
+	for _, key := range keys {
+		if key.Kid == keyID {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("JWKS doesn't contain the key with the '%s' ID", keyID)
+}
+
+func encodePKCS1PrivateKey(key *rsa.PrivateKey) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+}
@@ -0,0 +1,87 @@
+package okta
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNormalizePrivateKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs1 := encodePKCS1PrivateKey(key)
+	pkcs8Der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkcs8 := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: pkcs8Der}))
+	enc := func(i *big.Int) string { return base64.RawURLEncoding.EncodeToString(i.Bytes()) }
+	jwk := fmt.Sprintf(`{"kty":"RSA","kid":"key-1","n":"%s","e":"%s","d":"%s","p":"%s","q":"%s"}`,
+		enc(key.N), enc(big.NewInt(int64(key.E))), enc(key.D), enc(key.Primes[0]), enc(key.Primes[1]))
+	publicJWK := fmt.Sprintf(`{"kty":"RSA","n":"%s","e":"%s"}`, enc(key.N), enc(big.NewInt(int64(key.E))))
+	file := filepath.Join(t.TempDir(), "key.pem")
+	if err := os.WriteFile(file, []byte(pkcs8), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	otherJWK := fmt.Sprintf(`{"kty":"RSA","kid":"key-2","n":"%s","e":"%s","d":"%s","p":"%s","q":"%s"}`,
+		enc(key.N), enc(big.NewInt(int64(key.E))), enc(key.D), enc(key.Primes[0]), enc(key.Primes[1]))
+	jwks := fmt.Sprintf(`{"keys":[%s,%s]}`, otherJWK, jwk)
+
+	tests := []struct {
+		name          string
+		privateKey    string
+		privateKeyID  string
+		expectedKeyID string
+		expectError   bool
+	}{
+		{"PKCS#1", pkcs1, "", "", false},
+		{"PKCS#8", pkcs8, "", "", false},
+		{"file", file, "", "", false},
+		{"JWK", jwk, "", "key-1", false},
+		{"JWKS", fmt.Sprintf(`{"keys":[%s]}`, jwk), "", "key-1", false},
+		{"JWKS with multiple keys", jwks, "key-1", "key-1", false},
+		{"JWKS with multiple keys without key ID", jwks, "", "", true},
+		{"JWKS without the key", jwks, "key-3", "", true},
+		{"public JWK", publicJWK, "", "", true},
+		{"invalid", "privateKey", "", "", true},
+	}
+	for _, test := range tests {
+		privateKey, keyID, err := normalizePrivateKey(test.privateKey, test.privateKeyID)
+		if test.expectError {
+			if err == nil {
+				t.Errorf("test %q: expected error but received none", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("test %q: did not expect error but received error: %v", test.name, err)
+			continue
+		}
+		if keyID != test.expectedKeyID {
+			t.Errorf("test %q: expected key ID %q, got %q", test.name, test.expectedKeyID, keyID)
+		}
+		block, _ := pem.Decode([]byte(privateKey))
+		if block == nil {
+			t.Errorf("test %q: failed to decode PEM", test.name)
+			continue
+		}
+		parsed, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			t.Errorf("test %q: failed to parse PKCS#1 private key: %v", test.name, err)
+			continue
+		}
+		if !parsed.Equal(key) {
+			t.Errorf("test %q: private key doesn't match the original key", test.name)
+		}
+	}
+}
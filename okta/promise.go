@@ -18,7 +18,7 @@ func promiseAll(limit int, wg *sync.WaitGroup, resp chan []*result, funcs ...fun
 	resultList := make([]*result, len(funcs))
 
 	for jobIndex < len(funcs) {
-		for i := 0; i < limit; i++ {
+		for i := 0; i < limit && jobIndex < len(funcs); i++ {
 			wg.Add(1)
 			go func(index int, cb func() error) {
 				defer wg.Done()
@@ -48,3 +48,32 @@ func getPromiseError(resultList []*result, message string) error {
 
 	return nil
 }
+
+// runInParallel runs the functions concurrently up to the parallelism of the provider
+func runInParallel(m interface{}, message string, funcs []func() error) error {
+	if len(funcs) == 0 {
+		return nil
+	}
+	var wg sync.WaitGroup
+	resultChan := make(chan []*result, 1)
+	promiseAll(getParallelismFromMetadata(m), &wg, resultChan, funcs...)
+	return getPromiseError(<-resultChan, message)
+}
+
+// runInParallelWithErrors runs the functions concurrently up to the parallelism of the provider, and returns the
+// errors of the failed ones, so the callers can tell the partial failures apart
+func runInParallelWithErrors(m interface{}, funcs []func() error) []error {
+	if len(funcs) == 0 {
+		return nil
+	}
+	var wg sync.WaitGroup
+	resultChan := make(chan []*result, 1)
+	promiseAll(getParallelismFromMetadata(m), &wg, resultChan, funcs...)
+	var errs []error
+	for _, r := range <-resultChan {
+		if r.err != nil {
+			errs = append(errs, r.err)
+		}
+	}
+	return errs
+}
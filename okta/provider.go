@@ -37,6 +37,7 @@ const (
 	appSharedCredentials          = "okta_app_shared_credentials"
 	appSignOnPolicy               = "okta_app_signon_policy"
 	appSignOnPolicyRule           = "okta_app_signon_policy_rule"
+	apps                          = "okta_apps"
 	appSwa                        = "okta_app_swa"
 	appThreeField                 = "okta_app_three_field"
 	appUser                       = "okta_app_user"
@@ -49,6 +50,7 @@ const (
 	authServerClaimDefault        = "okta_auth_server_claim_default"
 	authServerClaims              = "okta_auth_server_claims"
 	authServerDefault             = "okta_auth_server_default"
+	authServerKeys                = "okta_auth_server_keys"
 	authServerPolicy              = "okta_auth_server_policy"
 	authServerPolicyRule          = "okta_auth_server_policy_rule"
 	authServerScope               = "okta_auth_server_scope"
@@ -61,9 +63,13 @@ const (
 	captchaOrgWideSettings        = "okta_captcha_org_wide_settings"
 	defaultPolicies               = "okta_default_policies"
 	defaultPolicy                 = "okta_default_policy"
+	device                        = "okta_device"
+	devices                       = "okta_devices"
 	domain                        = "okta_domain"
 	domainCertificate             = "okta_domain_certificate"
 	domainVerification            = "okta_domain_verification"
+	emailDomain                   = "okta_email_domain"
+	emailDomainVerification       = "okta_email_domain_verification"
 	emailSender                   = "okta_email_sender"
 	emailSenderVerification       = "okta_email_sender_verification"
 	emailCustomization            = "okta_email_customization"
@@ -74,10 +80,12 @@ const (
 	eventHookVerification         = "okta_event_hook_verification"
 	factor                        = "okta_factor"
 	factorTotp                    = "okta_factor_totp"
+	featureResource               = "okta_feature"
 	group                         = "okta_group"
 	groupEveryone                 = "okta_everyone_group"
 	groupMembership               = "okta_group_membership"
 	groupMemberships              = "okta_group_memberships"
+	groupOwner                    = "okta_group_owner"
 	groupRole                     = "okta_group_role"
 	groupRoles                    = "okta_group_roles"
 	groupRule                     = "okta_group_rule"
@@ -91,10 +99,19 @@ const (
 	inlineHook                    = "okta_inline_hook"
 	linkDefinition                = "okta_link_definition"
 	linkValue                     = "okta_link_value"
+	logEvents                     = "okta_log_events"
+	logStream                     = "okta_log_stream"
 	networkZone                   = "okta_network_zone"
+	networkZoneEnhancedDynamic    = "okta_network_zone_enhanced_dynamic"
 	orgConfiguration              = "okta_org_configuration"
+	orgMetadata                   = "okta_org_metadata"
 	orgSupport                    = "okta_org_support"
 	policy                        = "okta_policy"
+	policyDeviceAssuranceAndroid  = "okta_policy_device_assurance_android"
+	policyDeviceAssuranceChromeOS = "okta_policy_device_assurance_chromeos"
+	policyDeviceAssuranceIOS      = "okta_policy_device_assurance_ios"
+	policyDeviceAssuranceMacOS    = "okta_policy_device_assurance_macos"
+	policyDeviceAssuranceWindows  = "okta_policy_device_assurance_windows"
 	policyMfa                     = "okta_policy_mfa"
 	policyMfaDefault              = "okta_policy_mfa_default"
 	policyPassword                = "okta_policy_password"
@@ -107,6 +124,7 @@ const (
 	policyRuleProfileEnrollment   = "okta_policy_rule_profile_enrollment"
 	policyRuleSignOn              = "okta_policy_rule_signon"
 	policySignOn                  = "okta_policy_signon"
+	principalRateLimit            = "okta_principal_rate_limit"
 	profileMapping                = "okta_profile_mapping"
 	rateLimiting                  = "okta_rate_limiting"
 	resourceSet                   = "okta_resource_set"
@@ -122,10 +140,13 @@ const (
 	user                          = "okta_user"
 	userAdminRoles                = "okta_user_admin_roles"
 	userBaseSchemaProperty        = "okta_user_base_schema_property"
+	userFactor                    = "okta_user_factor"
 	userFactorQuestion            = "okta_user_factor_question"
 	userGroupMemberships          = "okta_user_group_memberships"
+	userPassword                  = "okta_user_password"
 	userProfileMappingSource      = "okta_user_profile_mapping_source"
 	users                         = "okta_users"
+	usersParallel                 = "okta_users_parallel"
 	userSchemaProperty            = "okta_user_schema_property"
 	userSecurityQuestions         = "okta_user_security_questions"
 	userType                      = "okta_user_type"
@@ -162,7 +183,7 @@ func Provider() *schema.Provider {
 				Type:          schema.TypeString,
 				Optional:      true,
 				DefaultFunc:   schema.EnvDefaultFunc("OKTA_API_CLIENT_ID", nil),
-				Description:   "API Token granting privileges to Okta API.",
+				Description:   "Client ID of the OAuth 2.0 service app, which is used to obtain the access token to Okta API.",
 				ConflictsWith: []string{"access_token", "api_token"},
 			},
 			"scopes": {
@@ -170,23 +191,44 @@ func Provider() *schema.Provider {
 				Optional:      true,
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				DefaultFunc:   envDefaultSetFunc("OKTA_API_SCOPES", nil),
-				Description:   "API Token granting privileges to Okta API.",
+				Description:   "Scopes of the access token to Okta API, which is obtained by the OAuth 2.0 service app.",
 				ConflictsWith: []string{"access_token", "api_token"},
 			},
 			"private_key": {
 				Optional:      true,
 				Type:          schema.TypeString,
 				DefaultFunc:   schema.EnvDefaultFunc("OKTA_API_PRIVATE_KEY", nil),
-				Description:   "API Token granting privileges to Okta API.",
+				Description:   "Private key of the OAuth 2.0 service app (PEM, JWK or JWKS), or the path to the file with it.",
+				Sensitive:     true,
 				ConflictsWith: []string{"access_token", "api_token"},
 			},
 			"private_key_id": {
 				Optional:      true,
 				Type:          schema.TypeString,
 				DefaultFunc:   schema.EnvDefaultFunc("OKTA_API_PRIVATE_KEY_ID", nil),
-				Description:   "API Token Id granting privileges to Okta API.",
+				Description:   "ID (kid) of the private key of the OAuth 2.0 service app.",
 				ConflictsWith: []string{"api_token"},
 			},
+			"dpop": {
+				Optional:    true,
+				Type:        schema.TypeBool,
+				DefaultFunc: schema.EnvDefaultFunc("OKTA_API_DPOP", false),
+				Description: "Whether the access tokens obtained with the 'private_key' are bound to the provider with DPoP (Demonstrating Proof-of-Possession), which is required by the OAuth 2.0 service apps with DPoP enabled.",
+			},
+			"credentials_file": {
+				Optional:      true,
+				Type:          schema.TypeString,
+				DefaultFunc:   schema.EnvDefaultFunc("OKTA_CREDENTIALS_FILE", nil),
+				Description:   "Path to the JSON file with the credentials of a single auth mode, which are used when no auth mode is set by the provider arguments.",
+				ConflictsWith: []string{"credentials_helper"},
+			},
+			"credentials_helper": {
+				Optional:      true,
+				Type:          schema.TypeString,
+				DefaultFunc:   schema.EnvDefaultFunc("OKTA_CREDENTIALS_HELPER", nil),
+				Description:   "Command, which prints the JSON with the credentials of a single auth mode, which are used when no auth mode is set by the provider arguments.",
+				ConflictsWith: []string{"credentials_file"},
+			},
 			"base_url": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -203,7 +245,7 @@ func Provider() *schema.Provider {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     true,
-				Description: "Use exponential back off strategy for rate limits.",
+				Description: "Use exponential back off strategy for rate limits, the rate limited requests are retried once the rate limit resets.",
 			},
 			"min_wait_seconds": {
 				Type:        schema.TypeInt,
@@ -290,19 +332,24 @@ func Provider() *schema.Provider {
 			brand:                         resourceBrand(),
 			captcha:                       resourceCaptcha(),
 			captchaOrgWideSettings:        resourceCaptchaOrgWideSettings(),
+			device:                        resourceDevice(),
 			domain:                        resourceDomain(),
 			domainCertificate:             resourceDomainCertificate(),
 			domainVerification:            resourceDomainVerification(),
 			emailCustomization:            resourceEmailCustomization(),
+			emailDomain:                   resourceEmailDomain(),
+			emailDomainVerification:       resourceEmailDomainVerification(),
 			emailSender:                   resourceEmailSender(),
 			emailSenderVerification:       resourceEmailSenderVerification(),
 			eventHook:                     resourceEventHook(),
 			eventHookVerification:         resourceEventHookVerification(),
 			factor:                        resourceFactor(),
 			factorTotp:                    resourceFactorTOTP(),
+			featureResource:               resourceFeature(),
 			group:                         resourceGroup(),
 			groupMembership:               resourceGroupMembership(),
 			groupMemberships:              resourceGroupMemberships(),
+			groupOwner:                    resourceGroupOwner(),
 			groupRole:                     resourceGroupRole(),
 			groupRoles:                    resourceGroupRoles(),
 			groupRule:                     resourceGroupRule(),
@@ -314,9 +361,16 @@ func Provider() *schema.Provider {
 			inlineHook:                    resourceInlineHook(),
 			linkDefinition:                resourceLinkDefinition(),
 			linkValue:                     resourceLinkValue(),
+			logStream:                     resourceLogStream(),
 			networkZone:                   resourceNetworkZone(),
+			networkZoneEnhancedDynamic:    resourceNetworkZoneEnhancedDynamic(),
 			orgConfiguration:              resourceOrgConfiguration(),
 			orgSupport:                    resourceOrgSupport(),
+			policyDeviceAssuranceAndroid:  resourcePolicyDeviceAssuranceAndroid(),
+			policyDeviceAssuranceChromeOS: resourcePolicyDeviceAssuranceChromeOS(),
+			policyDeviceAssuranceIOS:      resourcePolicyDeviceAssuranceIOS(),
+			policyDeviceAssuranceMacOS:    resourcePolicyDeviceAssuranceMacOS(),
+			policyDeviceAssuranceWindows:  resourcePolicyDeviceAssuranceWindows(),
 			policyMfa:                     resourcePolicyMfa(),
 			policyMfaDefault:              resourcePolicyMfaDefault(),
 			policyPassword:                resourcePolicyPassword(),
@@ -329,6 +383,7 @@ func Provider() *schema.Provider {
 			policyRuleProfileEnrollment:   resourcePolicyProfileEnrollmentRule(),
 			policyRuleSignOn:              resourcePolicySignOnRule(),
 			policySignOn:                  resourcePolicySignOn(),
+			principalRateLimit:            resourcePrincipalRateLimit(),
 			profileMapping:                resourceProfileMapping(),
 			rateLimiting:                  resourceRateLimiting(),
 			resourceSet:                   resourceResourceSet(),
@@ -342,10 +397,13 @@ func Provider() *schema.Provider {
 			user:                          resourceUser(),
 			userAdminRoles:                resourceUserAdminRoles(),
 			userBaseSchemaProperty:        resourceUserBaseSchemaProperty(),
+			userFactor:                    resourceUserFactor(),
 			userFactorQuestion:            resourceUserFactorQuestion(),
 			userGroupMemberships:          resourceUserGroupMemberships(),
+			userPassword:                  resourceUserPassword(),
 			userSchemaProperty:            resourceUserCustomSchemaProperty(),
 			userType:                      resourceUserType(),
+			usersParallel:                 resourceUsersParallel(),
 
 			// The day I realized I was naming stuff wrong :'-(
 			"okta_idp":                       deprecateIncorrectNaming(resourceIdpOidc(), idpOidc),
@@ -379,10 +437,12 @@ func Provider() *schema.Provider {
 			appSaml:                  dataSourceAppSaml(),
 			appSignOnPolicy:          dataSourceAppSignOnPolicy(),
 			appUserAssignments:       dataSourceAppUserAssignments(),
+			apps:                     dataSourceApps(),
 			authenticator:            dataSourceAuthenticator(),
 			authServer:               dataSourceAuthServer(),
 			authServerClaim:          dataSourceAuthServerClaim(),
 			authServerClaims:         dataSourceAuthServerClaims(),
+			authServerKeys:           dataSourceAuthServerKeys(),
 			authServerPolicy:         dataSourceAuthServerPolicy(),
 			authServerScopes:         dataSourceAuthServerScopes(),
 			behavior:                 dataSourceBehavior(),
@@ -395,6 +455,8 @@ func Provider() *schema.Provider {
 			emailTemplates:           dataSourceEmailTemplates(),
 			defaultPolicies:          deprecatedPolicies,
 			defaultPolicy:            dataSourceDefaultPolicy(),
+			devices:                  dataSourceDevices(),
+			featureResource:          dataSourceFeature(),
 			group:                    dataSourceGroup(),
 			groupEveryone:            dataSourceEveryoneGroup(),
 			groups:                   dataSourceGroups(),
@@ -402,7 +464,9 @@ func Provider() *schema.Provider {
 			idpOidc:                  dataSourceIdpOidc(),
 			idpSaml:                  dataSourceIdpSaml(),
 			idpSocial:                dataSourceIdpSocial(),
+			logEvents:                dataSourceLogEvents(),
 			networkZone:              dataSourceNetworkZone(),
+			orgMetadata:              dataSourceOrgMetadata(),
 			policy:                   dataSourcePolicy(),
 			roleSubscription:         dataSourceRoleSubscription(),
 			theme:                    dataSourceTheme(),
@@ -434,6 +498,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		privateKey:     d.Get("private_key").(string),
 		privateKeyId:   d.Get("private_key_id").(string),
 		scopes:         convertInterfaceToStringSet(d.Get("scopes")),
+		dpop:           d.Get("dpop").(bool),
 		retryCount:     d.Get("max_retries").(int),
 		parallelism:    d.Get("parallelism").(int),
 		backoff:        d.Get("backoff").(bool),
@@ -451,6 +516,9 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	if v := os.Getenv("OKTA_API_SCOPES"); v != "" && len(config.scopes) == 0 {
 		config.scopes = strings.Split(v, ",")
 	}
+	if err := config.loadCredentials(ctx, d.Get("credentials_file").(string), d.Get("credentials_helper").(string)); err != nil {
+		return nil, diag.Errorf("[ERROR] failed to load credentials: %v", err)
+	}
 	if err := config.loadAndValidate(ctx); err != nil {
 		return nil, diag.Errorf("[ERROR] invalid configuration: %v", err)
 	}
@@ -14,15 +14,26 @@ var validCustomRolePermissions = []string{
 	"okta.authzServers.manage",
 	"okta.authzServers.read",
 	"okta.apps.assignment.manage",
+	"okta.apps.clientCredentials.read",
 	"okta.apps.manage",
 	"okta.apps.read",
 	"okta.customizations.manage",
 	"okta.customizations.read",
+	"okta.devices.lifecycle.activate",
+	"okta.devices.lifecycle.deactivate",
+	"okta.devices.lifecycle.delete",
+	"okta.devices.lifecycle.manage",
+	"okta.devices.lifecycle.suspend",
+	"okta.devices.lifecycle.unsuspend",
+	"okta.devices.manage",
+	"okta.devices.read",
 	"okta.groups.appAssignment.manage",
 	"okta.groups.create",
 	"okta.groups.manage",
 	"okta.groups.members.manage",
 	"okta.groups.read",
+	"okta.identityProviders.manage",
+	"okta.identityProviders.read",
 	"okta.profilesources.import.run",
 	"okta.users.appAssignment.manage",
 	"okta.users.create",
@@ -43,6 +54,7 @@ var validCustomRolePermissions = []string{
 	"okta.users.read",
 	"okta.users.userprofile.manage",
 	"okta.workflows.invoke",
+	"okta.workflows.read",
 }
 
 func resourceAdminRoleCustom() *schema.Resource {
@@ -54,12 +66,11 @@ func resourceAdminRoleCustom() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		Description: "Resource to manage administrative Role assignments for a User",
+		Description: "Resource to manage custom administrative Roles, which are the custom collections of permissions",
 		Schema: map[string]*schema.Schema{
 			"label": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "The name given to the new Role",
 			},
 			"description": {
@@ -90,7 +101,7 @@ func resourceAdminRoleCustomCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("failed to create custom admin role: %v", err)
 	}
 	d.SetId(role.Id)
-	return nil
+	return resourceAdminRoleCustomRead(ctx, d, m)
 }
 
 func resourceAdminRoleCustomRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -126,7 +137,7 @@ func resourceAdminRoleCustomUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 	if !d.HasChange("permissions") {
-		return nil
+		return resourceAdminRoleCustomRead(ctx, d, m)
 	}
 	oldPermissions, newPermissions := d.GetChange("permissions")
 	oldSet := oldPermissions.(*schema.Set)
@@ -143,7 +154,7 @@ func resourceAdminRoleCustomUpdate(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	return nil
+	return resourceAdminRoleCustomRead(ctx, d, m)
 }
 
 func resourceAdminRoleCustomDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
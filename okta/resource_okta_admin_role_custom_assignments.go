@@ -87,7 +87,7 @@ func resourceAdminRoleCustomAssignmentsUpdate(ctx context.Context, d *schema.Res
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	return nil
+	return resourceAdminRoleCustomAssignmentsRead(ctx, d, m)
 }
 
 func resourceAdminRoleCustomAssignmentsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -112,19 +112,34 @@ func buildAdminRoleCustomAssignment(d *schema.ResourceData) (*sdk.CreateCustomRo
 func flattenAdminRoleCustomAssignments(members []*sdk.CustomRoleBindingMember) *schema.Set {
 	var arr []interface{}
 	for _, member := range members {
-		links := member.Links.(map[string]interface{})
-		var url string
-		for _, v := range links {
-			for _, link := range v.(map[string]interface{}) {
-				url = link.(string)
-				break
-			}
-		}
-		arr = append(arr, url)
+		arr = append(arr, customRoleBindingMemberHref(member))
 	}
 	return schema.NewSet(schema.HashString, arr)
 }
 
+// customRoleBindingMemberHref returns the href of the user or group the member points to, it's the 'self' link of the member
+func customRoleBindingMemberHref(member *sdk.CustomRoleBindingMember) string {
+	links, ok := member.Links.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	if self, ok := links["self"].(map[string]interface{}); ok {
+		if href, ok := self["href"].(string); ok {
+			return href
+		}
+	}
+	for _, v := range links {
+		link, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if href, ok := link["href"].(string); ok {
+			return href
+		}
+	}
+	return ""
+}
+
 func listResourceSetBindingMembers(ctx context.Context, client *sdk.APISupplement, resourceSetID, customRoleID string) ([]*sdk.CustomRoleBindingMember, *okta.Response, error) {
 	var resMembers []*sdk.CustomRoleBindingMember
 	resources, resp, err := client.ListResourceSetBindingMembers(ctx, resourceSetID, customRoleID, &query.Params{Limit: defaultPaginationLimit})
@@ -163,15 +178,7 @@ func removeMembersFromCustomAdminRole(ctx context.Context, client *sdk.APISupple
 		return fmt.Errorf("failed to list members assigned to the custom role: %v", err)
 	}
 	for _, member := range members {
-		links := member.Links.(map[string]interface{})
-		var url string
-		for _, v := range links {
-			for _, link := range v.(map[string]interface{}) {
-				url = link.(string)
-				break
-			}
-		}
-		if contains(urls, url) {
+		if contains(urls, customRoleBindingMemberHref(member)) {
 			_, err := client.DeleteResourceSetBindingMember(ctx, resourceSetID, roleID, member.Id)
 			if err != nil {
 				return fmt.Errorf("failed to remove %s member from the custom role: %v", member.Id, err)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 func TestAccOktaAdminRoleCustomAssignments(t *testing.T) {
@@ -44,3 +45,21 @@ func doesAdminRoleCustomAssignmentExist(id string) (bool, error) {
 	_, response, err := getSupplementFromMetadata(testAccProvider.Meta()).GetResourceSetBinding(context.Background(), parts[0], parts[1])
 	return doesResourceExist(response, err)
 }
+
+func TestCustomRoleBindingMemberHref(t *testing.T) {
+	tests := []struct {
+		links interface{}
+		href  string
+	}{
+		{map[string]interface{}{"self": map[string]interface{}{"href": "https://example.okta.com/api/v1/users/00u1"}}, "https://example.okta.com/api/v1/users/00u1"},
+		{map[string]interface{}{"group": map[string]interface{}{"href": "https://example.okta.com/api/v1/groups/00g1"}}, "https://example.okta.com/api/v1/groups/00g1"},
+		{map[string]interface{}{"self": "not a link"}, ""},
+		{nil, ""},
+	}
+	for _, test := range tests {
+		href := customRoleBindingMemberHref(&sdk.CustomRoleBindingMember{Links: test.links})
+		if href != test.href {
+			t.Errorf("expected %q, got %q", test.href, href)
+		}
+	}
+}
@@ -33,7 +33,7 @@ func TestAccOktaAdminRoleCustom(t *testing.T) {
 				{
 					Config: updated,
 					Check: resource.ComposeTestCheckFunc(
-						resource.TestCheckResourceAttr(resourceName, "label", buildResourceName(ri)),
+						resource.TestCheckResourceAttr(resourceName, "label", fmt.Sprintf("testAcc_updated_%d", ri)),
 						resource.TestCheckResourceAttr(resourceName, "description", "testing, testing updated"),
 						resource.TestCheckResourceAttr(resourceName, "permissions.#", "2"),
 					),
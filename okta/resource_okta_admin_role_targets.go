@@ -78,7 +78,11 @@ func resourceAdminRoleTargets() *schema.Resource {
 
 func resourceAdminRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	logger(m).Info("creating admin role targets", "role", d.Get("role_type").(string), "user", d.Get("user_id").(string))
-	err := checkRoleAssignment(ctx, d, m, d.Get("user_id").(string), d.Get("role_type").(string))
+	err := validateAdminRoleTargets(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = checkRoleAssignment(ctx, d, m, d.Get("user_id").(string), d.Get("role_type").(string))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -122,6 +126,9 @@ func resourceAdminRoleRead(ctx context.Context, d *schema.ResourceData, m interf
 
 func resourceAdminRoleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	logger(m).Info("updating admin role targets", "role", d.Get("role_type").(string), "user", d.Get("user_id").(string))
+	if err := validateAdminRoleTargets(d); err != nil {
+		return diag.FromErr(err)
+	}
 	if d.Get("role_type").(string) == "APP_ADMIN" {
 		expectedApps := convertInterfaceToStringSet(d.Get("apps"))
 		if len(expectedApps) == 0 {
@@ -179,6 +186,18 @@ func resourceAdminRoleDelete(ctx context.Context, d *schema.ResourceData, m inte
 	return nil
 }
 
+// validateAdminRoleTargets app targets are supported only by the APP_ADMIN role, the other roles are scoped by groups
+func validateAdminRoleTargets(d *schema.ResourceData) error {
+	roleType := d.Get("role_type").(string)
+	if roleType == "APP_ADMIN" && d.Get("groups").(*schema.Set).Len() > 0 {
+		return errors.New("'groups' targets can not be set for the 'APP_ADMIN' role, use 'apps' instead")
+	}
+	if roleType != "APP_ADMIN" && d.Get("apps").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("'apps' targets can only be set for the 'APP_ADMIN' role, use 'groups' for the '%s' role", roleType)
+	}
+	return nil
+}
+
 func splitTargets(expectedApps, existingApps []string) (appsToAdd, appsToRemove []string) {
 	for i := range expectedApps {
 		if !contains(existingApps, expectedApps[i]) {
@@ -278,7 +297,7 @@ func removeUserGroupTargets(ctx context.Context, d *schema.ResourceData, m inter
 		_, err := getOktaClientFromMetadata(m).User.RemoveGroupTargetFromRole(ctx,
 			d.Get("user_id").(string), d.Get("role_id").(string), groups[i])
 		if err != nil {
-			return fmt.Errorf("failed to add a group target to a group administrator role given to a user: %v", err)
+			return fmt.Errorf("failed to remove a group target from a group administrator role given to a user: %v", err)
 		}
 	}
 	return nil
@@ -324,8 +343,9 @@ func listUserApplicationTargets(ctx context.Context, d *schema.ResourceData, m i
 				if err := suppressErrorOn404(resp, err); err != nil {
 					return nil, err
 				}
+				// the app was deleted, so the target is no longer relevant
 				if a.Name == "" {
-					return nil, fmt.Errorf("something is wrong here, becase application was in the target list just a second ago, and now it's gone")
+					continue
 				}
 				resApps = append(resApps, fmt.Sprintf("%s.%s", a.Name, a.Id))
 			} else {
@@ -82,6 +82,7 @@ func resourceAppAutoLogin() *schema.Resource {
 			Create: schema.DefaultTimeout(1 * time.Hour),
 			Read:   schema.DefaultTimeout(1 * time.Hour),
 			Update: schema.DefaultTimeout(1 * time.Hour),
+			Delete: schema.DefaultTimeout(1 * time.Hour),
 		},
 	}
 }
@@ -129,11 +130,8 @@ func resourceAppAutoLoginRead(ctx context.Context, d *schema.ResourceData, m int
 	_ = d.Set("credentials_scheme", app.Credentials.Scheme)
 	_ = d.Set("reveal_password", app.Credentials.RevealPassword)
 	_ = d.Set("shared_username", app.Credentials.UserName) // We can sync shared username but not password from upstream
-	_ = d.Set("user_name_template", app.Credentials.UserNameTemplate.Template)
-	_ = d.Set("user_name_template_type", app.Credentials.UserNameTemplate.Type)
-	_ = d.Set("user_name_template_suffix", app.Credentials.UserNameTemplate.Suffix)
-	_ = d.Set("user_name_template_push_status", app.Credentials.UserNameTemplate.PushStatus)
-	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
+	setAppUserNameTemplate(d, app.Credentials.UserNameTemplate)
+	setAppLinkURLs(d, app.Links)
 	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility, app.Settings.Notes)
 	err = syncGroupsAndUsers(ctx, app.Id, d, m)
 	if err != nil {
@@ -37,6 +37,7 @@ func resourceAppBookmark() *schema.Resource {
 			Create: schema.DefaultTimeout(1 * time.Hour),
 			Read:   schema.DefaultTimeout(1 * time.Hour),
 			Update: schema.DefaultTimeout(1 * time.Hour),
+			Delete: schema.DefaultTimeout(1 * time.Hour),
 		},
 	}
 }
@@ -75,7 +76,7 @@ func resourceAppBookmarkRead(ctx context.Context, d *schema.ResourceData, m inte
 	_ = d.Set("url", app.Settings.App.Url)
 	_ = d.Set("request_integration", app.Settings.App.RequestIntegration)
 	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility, app.Settings.Notes)
-	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
+	setAppLinkURLs(d, app.Links)
 	err = syncGroupsAndUsers(ctx, app.Id, d, m)
 	if err != nil {
 		return diag.Errorf("failed to sync groups and users for bookmark application: %v", err)
@@ -322,6 +322,9 @@ func resourceAppOAuth() *schema.Resource {
 				StateFunc:        normalizeDataJSON,
 				Optional:         true,
 				Description:      "Custom JSON that represents an OAuth application's profile",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return (old == "" && new == "{}") || (old == "{}" && new == "")
+				},
 			},
 			"jwks": {
 				Type:     schema.TypeList,
@@ -385,6 +388,7 @@ func resourceAppOAuth() *schema.Resource {
 			Create: schema.DefaultTimeout(1 * time.Hour),
 			Read:   schema.DefaultTimeout(1 * time.Hour),
 			Update: schema.DefaultTimeout(1 * time.Hour),
+			Delete: schema.DefaultTimeout(1 * time.Hour),
 		},
 	}
 }
@@ -512,17 +516,8 @@ func resourceAppOAuthRead(ctx context.Context, d *schema.ResourceData, m interfa
 		d.SetId("")
 		return nil
 	}
-	var rawProfile string
-	if app.Profile != nil {
-		p, _ := json.Marshal(app.Profile)
-		rawProfile = string(p)
-	}
-	if app.Credentials.UserNameTemplate != nil {
-		_ = d.Set("user_name_template", app.Credentials.UserNameTemplate.Template)
-		_ = d.Set("user_name_template_type", app.Credentials.UserNameTemplate.Type)
-		_ = d.Set("user_name_template_suffix", app.Credentials.UserNameTemplate.Suffix)
-		_ = d.Set("user_name_template_push_status", app.Credentials.UserNameTemplate.PushStatus)
-	}
+	rawProfile := flattenAppOAuthProfile(app.Profile)
+	setAppUserNameTemplate(d, app.Credentials.UserNameTemplate)
 	appRead(d, app.Name, app.Status, app.SignOnMode, app.Label, app.Accessibility, app.Visibility, app.Settings.Notes)
 	_ = d.Set("profile", rawProfile)
 	// Not setting client_secret, it is only provided on create and update for auth methods that require it
@@ -536,7 +531,7 @@ func resourceAppOAuthRead(ctx context.Context, d *schema.ResourceData, m interfa
 	if err != nil {
 		return diag.Errorf("failed to set OAuth application settings: %v", err)
 	}
-	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
+	setAppLinkURLs(d, app.Links)
 	if app.Settings.ImplicitAssignment != nil {
 		_ = d.Set("implicit_assignment", *app.Settings.ImplicitAssignment)
 	} else {
@@ -844,11 +839,27 @@ func buildAppOAuth(d *schema.ResourceData) *okta.OpenIdConnectApplication {
 		str := rawAttrs.(string)
 		_ = json.Unmarshal([]byte(str), &attrs)
 		app.Profile = attrs
+	} else if d.HasChange("profile") {
+		// an omitted profile is left untouched by the API, an empty one clears it
+		app.Profile = map[string]interface{}{}
 	}
 
 	return app
 }
 
+// flattenAppOAuthProfile returns the normalized JSON of the app's profile, an empty profile is flattened to an empty
+// string so that it doesn't produce a diff against a configuration without a profile
+func flattenAppOAuthProfile(profile interface{}) string {
+	if profile == nil {
+		return ""
+	}
+	if p, ok := profile.(map[string]interface{}); ok && len(p) == 0 {
+		return ""
+	}
+	p, _ := json.Marshal(profile)
+	return normalizeDataJSON(string(p))
+}
+
 func validateGrantTypes(d *schema.ResourceData) error {
 	grantTypeList := convertInterfaceToStringSet(d.Get("grant_types"))
 	appType := d.Get("type").(string)
@@ -12,7 +12,7 @@ import (
 func resourceAppOAuthPostLogoutRedirectURI() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceAppOAuthPostLogoutRedirectURICreate,
-		ReadContext:   resourceFuncNoOp,
+		ReadContext:   resourceAppOAuthPostLogoutRedirectURIRead,
 		UpdateContext: resourceAppOAuthPostLogoutRedirectURIUpdate,
 		DeleteContext: resourceAppOAuthPostLogoutRedirectURIDelete,
 		// The id for this is the uri
@@ -39,7 +39,22 @@ func resourceAppOAuthPostLogoutRedirectURICreate(ctx context.Context, d *schema.
 		return diag.Errorf("failed to create post logout redirect URI: %v", err)
 	}
 	d.SetId(d.Get("uri").(string))
-	return resourceFuncNoOp(ctx, d, m)
+	return resourceAppOAuthPostLogoutRedirectURIRead(ctx, d, m)
+}
+
+func resourceAppOAuthPostLogoutRedirectURIRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	app := okta.NewOpenIdConnectApplication()
+	err := fetchAppByID(ctx, d.Get("app_id").(string), m, app)
+	if err != nil {
+		return diag.Errorf("failed to get application: %v", err)
+	}
+	if app.Id == "" || app.Settings == nil || app.Settings.OauthClient == nil ||
+		!contains(app.Settings.OauthClient.PostLogoutRedirectUris, d.Id()) {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("uri", d.Id())
+	return nil
 }
 
 func resourceAppOAuthPostLogoutRedirectURIUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -48,7 +63,7 @@ func resourceAppOAuthPostLogoutRedirectURIUpdate(ctx context.Context, d *schema.
 	}
 	// Normally not advisable, but ForceNew generated unnecessary calls
 	d.SetId(d.Get("uri").(string))
-	return resourceFuncNoOp(ctx, d, m)
+	return resourceAppOAuthPostLogoutRedirectURIRead(ctx, d, m)
 }
 
 func resourceAppOAuthPostLogoutRedirectURIDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -90,11 +105,20 @@ func appendPostLogoutRedirectURI(ctx context.Context, d *schema.ResourceData, m
 	if app.Id == "" {
 		return fmt.Errorf("application with id %s does not exist", appID)
 	}
-	if contains(app.Settings.OauthClient.PostLogoutRedirectUris, d.Id()) {
-		logger(m).Info(fmt.Sprintf("application with appID %s already has post logout redirect URI %s", appID, d.Id()))
-		return nil
+	uris := app.Settings.OauthClient.PostLogoutRedirectUris
+	// on update the previous URI is owned by this resource and has to be replaced rather than left behind
+	if d.HasChange("uri") {
+		oldURI, _ := d.GetChange("uri")
+		uris = remove(uris, oldURI.(string))
 	}
 	uri := d.Get("uri").(string)
-	app.Settings.OauthClient.PostLogoutRedirectUris = append(app.Settings.OauthClient.PostLogoutRedirectUris, uri)
+	if contains(uris, uri) && len(uris) == len(app.Settings.OauthClient.PostLogoutRedirectUris) {
+		logger(m).Info(fmt.Sprintf("application with appID %s already has post logout redirect URI %s", appID, uri))
+		return nil
+	}
+	if !contains(uris, uri) {
+		uris = append(uris, uri)
+	}
+	app.Settings.OauthClient.PostLogoutRedirectUris = uris
 	return updateAppByID(ctx, appID, m, app)
 }
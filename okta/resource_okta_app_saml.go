@@ -412,16 +412,12 @@ func resourceAppSaml() *schema.Resource {
 				Optional:    true,
 				Description: "Id of this apps authentication policy",
 			},
-			"embed_url": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "The url that can be used to embed this application in other portals.",
-			},
 		}),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Hour),
 			Read:   schema.DefaultTimeout(1 * time.Hour),
 			Update: schema.DefaultTimeout(1 * time.Hour),
+			Delete: schema.DefaultTimeout(1 * time.Hour),
 		},
 	}
 }
@@ -431,6 +427,10 @@ func resourceAppSamlCreate(ctx context.Context, d *schema.ResourceData, m interf
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	err = validateAppSamlInlineHook(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	app, err := buildSamlApp(d)
 	if err != nil {
 		return diag.Errorf("failed to create SAML application: %v", err)
@@ -498,13 +498,9 @@ func resourceAppSamlRead(ctx context.Context, d *schema.ResourceData, m interfac
 		}
 	}
 	_ = d.Set("features", convertStringSliceToSetNullable(app.Features))
-	_ = d.Set("user_name_template", app.Credentials.UserNameTemplate.Template)
-	_ = d.Set("user_name_template_type", app.Credentials.UserNameTemplate.Type)
-	_ = d.Set("user_name_template_suffix", app.Credentials.UserNameTemplate.Suffix)
-	_ = d.Set("user_name_template_push_status", app.Credentials.UserNameTemplate.PushStatus)
+	setAppUserNameTemplate(d, app.Credentials.UserNameTemplate)
 	_ = d.Set("preconfigured_app", app.Name)
-	_ = d.Set("logo_url", linksValue(app.Links, "logo", "href"))
-	_ = d.Set("embed_url", linksValue(app.Links, "appLinks", "href"))
+	setAppLinkURLs(d, app.Links)
 
 	if app.Settings.ImplicitAssignment != nil {
 		_ = d.Set("implicit_assignment", *app.Settings.ImplicitAssignment)
@@ -564,6 +560,10 @@ func resourceAppSamlUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	err = validateAppSamlInlineHook(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	client := getOktaClientFromMetadata(m)
 	app, err := buildSamlApp(d)
 	if err != nil {
@@ -778,3 +778,20 @@ func validateAppSaml(d *schema.ResourceData) error {
 	}
 	return nil
 }
+
+// validateAppSamlInlineHook makes sure the hook referenced by 'inline_hook_id' is a SAML assertion hook, otherwise the
+// API responds with a generic bad request error which is hard to trace back to the hook.
+func validateAppSamlInlineHook(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	id, ok := d.GetOk("inline_hook_id")
+	if !ok || !d.HasChange("inline_hook_id") {
+		return nil
+	}
+	hook, _, err := getOktaClientFromMetadata(m).InlineHook.GetInlineHook(ctx, id.(string))
+	if err != nil {
+		return fmt.Errorf("failed to get inline hook '%s' for SAML application: %v", id.(string), err)
+	}
+	if hook.Type != "com.okta.saml.tokens.transform" {
+		return fmt.Errorf("invalid 'inline_hook_id': inline hook '%s' has type '%s', SAML applications require an inline hook of type 'com.okta.saml.tokens.transform'", id.(string), hook.Type)
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 func resourceAppSignOnPolicy() *schema.Resource {
@@ -15,6 +16,7 @@ func resourceAppSignOnPolicy() *schema.Resource {
 		ReadContext:   resourceAppSignOnPolicyRead,
 		UpdateContext: resourceAppSignOnPolicyUpdate,
 		DeleteContext: resourceAppSignOnPolicyDelete,
+		Importer:      createPolicyImporter(sdk.AccessPolicyType),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -26,6 +28,12 @@ func resourceAppSignOnPolicy() *schema.Resource {
 				Required:    true,
 				Description: "Policy Description",
 			},
+			"deactivate_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Deactivate the policy on destroy instead of deleting it, the apps using it keep it as their authentication policy",
+			},
 		},
 	}
 }
@@ -72,8 +80,8 @@ func resourceAppSignOnPolicyRead(ctx context.Context, d *schema.ResourceData, m
 	}
 	policyFromServer := authenticationPolicy.(*okta.Policy)
 	d.SetId(policyFromServer.Id)
-	d.Set("name", policyFromServer.Name)
-	d.Set("description", policyFromServer.Description)
+	_ = d.Set("name", policyFromServer.Name)
+	_ = d.Set("description", policyFromServer.Description)
 	return nil
 }
 
@@ -104,8 +112,20 @@ func resourceAppSignOnPolicyDelete(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.Errorf("Error finding default access policy: %v", err)
 	}
+	if defaultPolicy.Id == d.Id() {
+		return diag.Errorf("the default authentication policy '%s' can't be deleted", d.Id())
+	}
 
 	client := getOktaClientFromMetadata(m)
+	if d.Get("deactivate_on_destroy").(bool) {
+		logger(m).Info("deactivating authentication policy instead of deleting it", "id", d.Id())
+		resp, err := client.Policy.DeactivatePolicy(ctx, d.Id())
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return diag.Errorf("failed to deactivate authentication policy: %v", err)
+		}
+		return nil
+	}
+
 	apps, err := listApps(ctx, client, nil, defaultPaginationLimit)
 	if err != nil {
 		return diag.Errorf("failed to list apps in preparation to delete authentication policy: %v", err)
@@ -126,8 +146,8 @@ func resourceAppSignOnPolicyDelete(ctx context.Context, d *schema.ResourceData,
 	}
 
 	// delete will error out if the policy is still associated with apps
-	_, err = client.Policy.DeletePolicy(ctx, d.Id())
-	if err != nil {
+	resp, err := client.Policy.DeletePolicy(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed delete authentication policy: %v", err)
 	}
 
@@ -3,11 +3,13 @@ package okta
 import (
 	"context"
 	"encoding/json"
+	"strings"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 func resourceAppSignOnPolicyRule() *schema.Resource {
@@ -120,6 +122,13 @@ func resourceAppSignOnPolicyRule() *schema.Resource {
 				Optional:    true,
 				Description: "This is an optional advanced setting. If the expression is formatted incorrectly or conflicts with conditions set above, the rule may not match any users.",
 			},
+			"risk_score": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: elemInSlice([]string{"ANY", "LOW", "MEDIUM", "HIGH"}),
+				Description:      "The risk score specifies a particular level of risk to match on: ANY, LOW, MEDIUM or HIGH",
+				Default:          "ANY",
+			},
 			"user_types_excluded": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -171,7 +180,7 @@ func resourceAppSignOnPolicyRule() *schema.Resource {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
 					Type:             schema.TypeString,
-					ValidateDiagFunc: stringIsJSON,
+					ValidateDiagFunc: stringIsAccessPolicyConstraint,
 					StateFunc:        normalizeDataJSON,
 				},
 				Optional:    true,
@@ -186,19 +195,32 @@ func resourceAppSignOnPolicyRuleCreate(ctx context.Context, d *schema.ResourceDa
 		return resourceOIEOnlyFeatureError(appSignOnPolicyRule)
 	}
 
-	rule, _, err := getSupplementFromMetadata(m).CreateAppSignOnPolicyRule(ctx, d.Get("policy_id").(string), buildAppSignOnPolicyRule(d))
+	if err := validatePolicyNetworkCondition(d); err != nil {
+		return diag.FromErr(err)
+	}
+	policyID := d.Get("policy_id").(string)
+	oktaMutexKV.Lock(policyID)
+	defer oktaMutexKV.Unlock(policyID)
+	template := buildAppSignOnPolicyRule(d)
+	rule, _, err := getSupplementFromMetadata(m).CreateAppSignOnPolicyRule(ctx, policyID, template)
 	if err != nil {
 		return diag.Errorf("failed to create app sign on policy rule: %v", err)
 	}
 	d.SetId(rule.Id)
 	if status, ok := d.GetOk("status"); ok {
 		if status.(string) == statusInactive {
-			_, err = getSupplementFromMetadata(m).DeactivateAppSignOnPolicyRule(ctx, d.Get("policy_id").(string), d.Id())
+			_, err = getSupplementFromMetadata(m).DeactivateAppSignOnPolicyRule(ctx, policyID, d.Id())
 			if err != nil {
 				return diag.Errorf("failed to deactivate app sign on policy rule: %v", err)
 			}
 		}
 	}
+	// the API puts the rule last when there are not enough siblings for the configured priority yet,
+	// so it's applied again once they are created
+	err = reconcileAppSignOnPolicyRulePriority(ctx, m, policyID, rule.Id, template)
+	if err != nil {
+		return diag.Errorf("failed to set app sign on policy rule priority: %v", err)
+	}
 	return resourceAppSignOnPolicyRuleRead(ctx, d, m)
 }
 
@@ -218,7 +240,7 @@ func resourceAppSignOnPolicyRuleRead(ctx context.Context, d *schema.ResourceData
 	_ = d.Set("name", rule.Name)
 	_ = d.Set("priority", int(rule.Priority))
 	_ = d.Set("status", rule.Status)
-	if rule.Actions.AppSignOn != nil {
+	if rule.Actions != nil && rule.Actions.AppSignOn != nil {
 		_ = d.Set("access", rule.Actions.AppSignOn.Access)
 		if rule.Actions.AppSignOn.VerificationMethod != nil {
 			_ = d.Set("type", rule.Actions.AppSignOn.VerificationMethod.Type)
@@ -240,12 +262,11 @@ func resourceAppSignOnPolicyRuleRead(ctx context.Context, d *schema.ResourceData
 		m := map[string]interface{}{
 			"platform_include": flattenAccessPolicyPlatformInclude(rule.Conditions.Platform),
 		}
-		_ = d.Set("network_connection", rule.Conditions.Network.Connection)
-		if len(rule.Conditions.Network.Include) > 0 {
-			m["network_includes"] = convertStringSliceToInterfaceSlice(rule.Conditions.Network.Include)
+		if err := syncPolicyNetworkCondition(d, rule.Conditions.Network); err != nil {
+			return diag.Errorf("failed to set app sign on policy rule network conditions: %v", err)
 		}
-		if len(rule.Conditions.Network.Exclude) > 0 {
-			m["network_excludes"] = convertStringSliceToInterfaceSlice(rule.Conditions.Network.Exclude)
+		if rule.Conditions.RiskScore != nil {
+			_ = d.Set("risk_score", rule.Conditions.RiskScore.Level)
 		}
 		if rule.Conditions.Device != nil {
 			_ = d.Set("device_is_managed", rule.Conditions.Device.Managed)
@@ -275,21 +296,32 @@ func resourceAppSignOnPolicyRuleUpdate(ctx context.Context, d *schema.ResourceDa
 		return resourceOIEOnlyFeatureError(appSignOnPolicyRule)
 	}
 
-	_, _, err := getSupplementFromMetadata(m).UpdateAppSignOnPolicyRule(ctx, d.Get("policy_id").(string), d.Id(), buildAppSignOnPolicyRule(d))
+	if err := validatePolicyNetworkCondition(d); err != nil {
+		return diag.FromErr(err)
+	}
+	policyID := d.Get("policy_id").(string)
+	oktaMutexKV.Lock(policyID)
+	defer oktaMutexKV.Unlock(policyID)
+	template := buildAppSignOnPolicyRule(d)
+	_, _, err := getSupplementFromMetadata(m).UpdateAppSignOnPolicyRule(ctx, policyID, d.Id(), template)
 	if err != nil {
-		return diag.Errorf("failed to create app sign on policy rule: %v", err)
+		return diag.Errorf("failed to update app sign on policy rule: %v", err)
 	}
 	oldStatus, newStatus := d.GetChange("status")
 	if oldStatus != newStatus {
 		if newStatus == statusActive {
-			_, err = getSupplementFromMetadata(m).ActivateAppSignOnPolicyRule(ctx, d.Get("policy_id").(string), d.Id())
+			_, err = getSupplementFromMetadata(m).ActivateAppSignOnPolicyRule(ctx, policyID, d.Id())
 		} else {
-			_, err = getSupplementFromMetadata(m).DeactivateAppSignOnPolicyRule(ctx, d.Get("policy_id").(string), d.Id())
+			_, err = getSupplementFromMetadata(m).DeactivateAppSignOnPolicyRule(ctx, policyID, d.Id())
 		}
 		if err != nil {
 			return diag.Errorf("failed to change app sign on policy rule status: %v", err)
 		}
 	}
+	err = reconcileAppSignOnPolicyRulePriority(ctx, m, policyID, d.Id(), template)
+	if err != nil {
+		return diag.Errorf("failed to set app sign on policy rule priority: %v", err)
+	}
 	return resourceAppSignOnPolicyRuleRead(ctx, d, m)
 }
 
@@ -302,19 +334,28 @@ func resourceAppSignOnPolicyRuleDelete(ctx context.Context, d *schema.ResourceDa
 		// You cannot delete a default rule in a policy
 		return nil
 	}
-	_, err := getSupplementFromMetadata(m).DeleteAppSignOnPolicyRule(ctx, d.Get("policy_id").(string), d.Id())
-	if err != nil {
+	priorities.forget(d.Id())
+	policyID := d.Get("policy_id").(string)
+	oktaMutexKV.Lock(policyID)
+	defer oktaMutexKV.Unlock(policyID)
+	resp, err := getSupplementFromMetadata(m).DeleteAppSignOnPolicyRule(ctx, policyID, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to delete app sign-on policy rule: %v", err)
 	}
 	return nil
 }
 
-func buildAppSignOnPolicyRule(d *schema.ResourceData) okta.AccessPolicyRule {
-	rule := okta.AccessPolicyRule{
-		Actions: &okta.AccessPolicyRuleActions{
-			AppSignOn: &okta.AccessPolicyRuleApplicationSignOn{
+func buildAppSignOnPolicyRule(d *schema.ResourceData) sdk.AccessPolicyRule {
+	rule := sdk.AccessPolicyRule{
+		AccessPolicyRule: okta.AccessPolicyRule{
+			Name:     d.Get("name").(string),
+			Priority: int64(d.Get("priority").(int)),
+			Type:     "ACCESS_POLICY",
+		},
+		Actions: &sdk.AccessPolicyRuleActions{
+			AppSignOn: &sdk.AccessPolicyRuleApplicationSignOn{
 				Access: d.Get("access").(string),
-				VerificationMethod: &okta.VerificationMethod{
+				VerificationMethod: &sdk.VerificationMethod{
 					FactorMode:       d.Get("factor_mode").(string),
 					ReauthenticateIn: d.Get("re_authentication_frequency").(string),
 					InactivityPeriod: d.Get("inactivity_period").(string),
@@ -322,16 +363,13 @@ func buildAppSignOnPolicyRule(d *schema.ResourceData) okta.AccessPolicyRule {
 				},
 			},
 		},
-		Name:     d.Get("name").(string),
-		Priority: int64(d.Get("priority").(int)),
-		Type:     "ACCESS_POLICY",
 	}
-	var constraints []*okta.AccessPolicyConstraints
+	var constraints []*sdk.AccessPolicyConstraints
 	v, ok := d.GetOk("constraints")
 	if ok {
 		valueList := v.([]interface{})
 		for _, item := range valueList {
-			var constraint okta.AccessPolicyConstraints
+			var constraint sdk.AccessPolicyConstraints
 			_ = json.Unmarshal([]byte(item.(string)), &constraint)
 			constraints = append(constraints, &constraint)
 		}
@@ -349,6 +387,9 @@ func buildAppSignOnPolicyRule(d *schema.ResourceData) okta.AccessPolicyRule {
 		ElCondition: &okta.AccessPolicyRuleCustomCondition{
 			Condition: d.Get("custom_expression").(string),
 		},
+		RiskScore: &okta.RiskScorePolicyRuleCondition{
+			Level: d.Get("risk_score").(string),
+		},
 	}
 	isRegistered, ok := d.GetOk("device_is_registered")
 	if ok && isRegistered.(bool) {
@@ -433,3 +474,35 @@ func flattenAccessPolicyPlatformInclude(platform *okta.PlatformPolicyRuleConditi
 	}
 	return schema.NewSet(schema.HashResource(platformIncludeResource), flattened)
 }
+
+// stringIsAccessPolicyConstraint validates that the constraint contains only the known authenticator
+// classes and attributes, since unknown ones are silently dropped by the API.
+func stringIsAccessPolicyConstraint(i interface{}, k cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of %s to be string", k)
+	}
+	dec := json.NewDecoder(strings.NewReader(v))
+	dec.DisallowUnknownFields()
+	var constraint sdk.AccessPolicyConstraints
+	if err := dec.Decode(&constraint); err != nil {
+		return diag.Errorf("'%s' is not a valid authenticator constraint: %v", v, err)
+	}
+	if constraint.Knowledge == nil && constraint.Possession == nil {
+		return diag.Errorf("'%s' authenticator constraint should contain either 'knowledge' or 'possession' object", v)
+	}
+	if p := constraint.Possession; p != nil {
+		for name, value := range map[string]string{
+			"deviceBound":        p.DeviceBound,
+			"hardwareProtection": p.HardwareProtection,
+			"phishingResistant":  p.PhishingResistant,
+			"userPresence":       p.UserPresence,
+			"userVerification":   p.UserVerification,
+		} {
+			if value != "" && value != "REQUIRED" && value != "OPTIONAL" {
+				return diag.Errorf("'%s' of the possession constraint should be either 'REQUIRED' or 'OPTIONAL', got '%s'", name, value)
+			}
+		}
+	}
+	return nil
+}
@@ -44,6 +44,7 @@ func TestAccOktaAppSignOnPolicyRule(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "constraints.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "re_authentication_frequency", "PT2H"),
 					resource.TestCheckResourceAttr(resourceName, "inactivity_period", "PT1H"),
+					resource.TestCheckResourceAttr(resourceName, "risk_score", "ANY"),
 				),
 			},
 			{
@@ -68,6 +69,7 @@ func TestAccOktaAppSignOnPolicyRule(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "inactivity_period", "PT2H"),
 					resource.TestCheckResourceAttr(resourceName, "type", "ASSURANCE"),
 					resource.TestCheckResourceAttr(resourceName, "constraints.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "risk_score", "MEDIUM"),
 				),
 			},
 		},
@@ -93,3 +95,24 @@ func appSignOnPolicyRuleExists(s *terraform.State) error {
 	}
 	return nil
 }
+
+func TestStringIsAccessPolicyConstraint(t *testing.T) {
+	tests := []struct {
+		constraint string
+		valid      bool
+	}{
+		{`{"knowledge":{"types":["password"]}}`, true},
+		{`{"possession":{"phishingResistant":"REQUIRED","userVerification":"OPTIONAL"}}`, true},
+		{`{}`, false},
+		{`{"posession":{"deviceBound":"REQUIRED"}}`, false},
+		{`{"possession":{"phishing_resistant":"REQUIRED"}}`, false},
+		{`{"possession":{"hardwareProtection":"YES"}}`, false},
+		{`not json`, false},
+	}
+	for _, tc := range tests {
+		diags := stringIsAccessPolicyConstraint(tc.constraint, nil)
+		if diags.HasError() == tc.valid {
+			t.Errorf("constraint %s: expected valid=%t, got %v", tc.constraint, tc.valid, diags)
+		}
+	}
+}
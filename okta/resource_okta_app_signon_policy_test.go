@@ -30,6 +30,12 @@ func TestAccOktaAppSignOnPolicy_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", "The app signon policy used by our test app."),
 				),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deactivate_on_destroy"},
+			},
 			{
 				Config: updatedConfig,
 				Check: resource.ComposeTestCheckFunc(
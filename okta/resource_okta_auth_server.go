@@ -26,16 +26,19 @@ func resourceAuthServer() *schema.Resource {
 			},
 			"status": statusSchema,
 			"kid": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the current signing key",
 			},
 			"credentials_last_rotated": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the signing keys were last rotated",
 			},
 			"credentials_next_rotation": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the signing keys are rotated next, only set when credentials_rotation_mode is AUTO",
 			},
 			"credentials_rotation_mode": {
 				Type:             schema.TypeString,
@@ -44,6 +47,11 @@ func resourceAuthServer() *schema.Resource {
 				Default:          "AUTO",
 				Description:      "Credential rotation mode, in many cases you cannot set this to MANUAL, the API will ignore the value and you will get a perpetual diff. This should rarely be used.",
 			},
+			"credentials_rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value, changing it rotates the signing keys. Can only be used when credentials_rotation_mode is MANUAL",
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -75,6 +83,13 @@ func resourceAuthServerCreate(ctx context.Context, d *schema.ResourceData, m int
 		return diag.Errorf("failed to create authorization server: %v", err)
 	}
 	d.SetId(responseAuthServer.Id)
+	// auth servers are always created active, so they are deactivated right away to be staged
+	if d.Get("status").(string) != statusActive {
+		dErr := handleAuthServerLifecycle(ctx, d, m)
+		if dErr != nil {
+			return dErr
+		}
+	}
 	if d.Get("credentials_rotation_mode").(string) == "MANUAL" {
 		// Auth servers can only be set to manual on update. No clue why.
 		dErr := resourceAuthServerUpdate(ctx, d, m)
@@ -121,7 +136,8 @@ func resourceAuthServerRead(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 func resourceAuthServerUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	if d.HasChange("status") {
+	// the status of a new auth server is already handled on create
+	if !d.IsNewResource() && d.HasChange("status") {
 		err := handleAuthServerLifecycle(ctx, d, m)
 		if err != nil {
 			return err
@@ -132,6 +148,16 @@ func resourceAuthServerUpdate(ctx context.Context, d *schema.ResourceData, m int
 	if err != nil {
 		return diag.Errorf("failed to update authorization server: %v", err)
 	}
+	// keys are only rotated when the trigger changes on update, the keys of a new auth server are fresh anyway
+	if !d.IsNewResource() && d.HasChange("credentials_rotation_trigger") && d.Get("credentials_rotation_trigger").(string) != "" {
+		if d.Get("credentials_rotation_mode").(string) != "MANUAL" {
+			return diag.Errorf("signing keys can only be rotated when 'credentials_rotation_mode' is 'MANUAL'")
+		}
+		_, _, err = getOktaClientFromMetadata(m).AuthorizationServer.RotateAuthorizationServerKeys(ctx, d.Id(), okta.JwkUse{Use: "sig"})
+		if err != nil {
+			return diag.Errorf("failed to rotate authorization server signing keys: %v", err)
+		}
+	}
 	return resourceAuthServerRead(ctx, d, m)
 }
 
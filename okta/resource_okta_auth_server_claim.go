@@ -2,6 +2,7 @@ package okta
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,6 +26,7 @@ func resourceAuthServerClaim() *schema.Resource {
 				Type:        schema.TypeString,
 				Required:    true,
 				Description: "Auth server ID",
+				ForceNew:    true,
 			},
 			"scopes": {
 				Type:        schema.TypeSet,
@@ -34,24 +36,28 @@ func resourceAuthServerClaim() *schema.Resource {
 			},
 			"status": statusSchema,
 			"value": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Value of the claim, an Okta expression or the group filter when value_type is GROUPS",
 			},
 			"value_type": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				ValidateDiagFunc: elemInSlice([]string{"EXPRESSION", "GROUPS", "SYSTEM"}),
 				Default:          "EXPRESSION",
+				Description:      "Type of the value of the claim: EXPRESSION or GROUPS, SYSTEM is only used by the default claims",
 			},
 			"claim_type": {
 				Type:             schema.TypeString,
 				Required:         true,
 				ValidateDiagFunc: elemInSlice([]string{"RESOURCE", "IDENTITY"}),
+				Description:      "Whether the claim is for an access token (RESOURCE) or an ID token (IDENTITY)",
 			},
 			"always_include_in_token": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  true,
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to include the claim in the token regardless of the requested scopes",
 			},
 			"group_filter_type": {
 				Type:             schema.TypeString,
@@ -64,6 +70,9 @@ func resourceAuthServerClaim() *schema.Resource {
 }
 
 func resourceAuthServerClaimCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateAuthServerClaim(d); err != nil {
+		return diag.FromErr(err)
+	}
 	claim := buildAuthServerClaim(d)
 	respClaim, _, err := getOktaClientFromMetadata(m).AuthorizationServer.CreateOAuth2Claim(ctx, d.Get("auth_server_id").(string), claim)
 	if err != nil {
@@ -82,8 +91,13 @@ func resourceAuthServerClaimRead(ctx context.Context, d *schema.ResourceData, m
 		d.SetId("")
 		return nil
 	}
-	if claim.Conditions != nil && len(claim.Conditions.Scopes) > 0 {
-		_ = d.Set("scopes", convertStringSliceToSet(claim.Conditions.Scopes))
+	var scopes []string
+	if claim.Conditions != nil {
+		scopes = claim.Conditions.Scopes
+	}
+	err = d.Set("scopes", convertStringSliceToSetNullable(scopes))
+	if err != nil {
+		return diag.Errorf("failed to set auth server claim scopes: %v", err)
 	}
 	_ = d.Set("name", claim.Name)
 	_ = d.Set("status", claim.Status)
@@ -96,6 +110,9 @@ func resourceAuthServerClaimRead(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceAuthServerClaimUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validateAuthServerClaim(d); err != nil {
+		return diag.FromErr(err)
+	}
 	claim := buildAuthServerClaim(d)
 	_, _, err := getOktaClientFromMetadata(m).AuthorizationServer.UpdateOAuth2Claim(ctx, d.Get("auth_server_id").(string), d.Id(), claim)
 	if err != nil {
@@ -110,8 +127,8 @@ func resourceAuthServerClaimDelete(ctx context.Context, d *schema.ResourceData,
 	if d.Get("value_type").(string) == "SYSTEM" && d.Get("always_include_in_token").(bool) {
 		return nil
 	}
-	_, err := getOktaClientFromMetadata(m).AuthorizationServer.DeleteOAuth2Claim(ctx, d.Get("auth_server_id").(string), d.Id())
-	if err != nil {
+	resp, err := getOktaClientFromMetadata(m).AuthorizationServer.DeleteOAuth2Claim(ctx, d.Get("auth_server_id").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to delete auth server claim: %v", err)
 	}
 	return nil
@@ -129,3 +146,15 @@ func buildAuthServerClaim(d *schema.ResourceData) okta.OAuth2Claim {
 		GroupFilterType:      d.Get("group_filter_type").(string),
 	}
 }
+
+func validateAuthServerClaim(d *schema.ResourceData) error {
+	_, ok := d.GetOk("group_filter_type")
+	isGroups := d.Get("value_type").(string) == "GROUPS"
+	if ok && !isGroups {
+		return errors.New("'group_filter_type' can only be set when 'value_type' is 'GROUPS'")
+	}
+	if !ok && isGroups {
+		return errors.New("'group_filter_type' is required when 'value_type' is 'GROUPS'")
+	}
+	return nil
+}
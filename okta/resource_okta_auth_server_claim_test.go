@@ -49,6 +49,7 @@ func TestAccOktaAuthServerClaim_groupType(t *testing.T) {
 	ri := acctest.RandInt()
 	resourceName := fmt.Sprintf("%s.test", authServerClaim)
 	swResourceName := fmt.Sprintf("%s.test_sw", authServerClaim)
+	regexResourceName := fmt.Sprintf("%s.test_regex", authServerClaim)
 	mgr := newFixtureManager(authServerClaim)
 	config := mgr.GetFixtures("basic_group.tf", ri, t)
 
@@ -74,6 +75,36 @@ func TestAccOktaAuthServerClaim_groupType(t *testing.T) {
 					resource.TestCheckResourceAttr(swResourceName, "value_type", "GROUPS"),
 					resource.TestCheckResourceAttr(swResourceName, "value", "Every"),
 					resource.TestCheckResourceAttr(swResourceName, "claim_type", "RESOURCE"),
+
+					resource.TestCheckResourceAttr(regexResourceName, "group_filter_type", "REGEX"),
+					resource.TestCheckResourceAttr(regexResourceName, "value_type", "GROUPS"),
+					resource.TestCheckResourceAttr(regexResourceName, "value", ".*"),
+					resource.TestCheckResourceAttr(regexResourceName, "claim_type", "IDENTITY"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOktaAuthServerClaim_groupWhitelist(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", authServerClaim)
+	mgr := newFixtureManager(authServerClaim)
+	config := mgr.GetFixtures("group_whitelist.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(authServer, authServerExists),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "groups"),
+					resource.TestCheckResourceAttr(resourceName, "value_type", "EXPRESSION"),
+					resource.TestCheckResourceAttr(resourceName, "value", `Groups.getFilteredGroups(app.profile.groups.whitelist, "group.id", 50)`),
+					resource.TestCheckResourceAttr(resourceName, "claim_type", "IDENTITY"),
 				),
 			},
 		},
@@ -65,10 +65,15 @@ func resourceAuthServerPolicyRule() *schema.Resource {
 				// 5 minutes - 1 day
 				ValidateDiagFunc: intBetween(5, 1440),
 				Default:          60,
+				Description:      "Lifetime of access token. Can be set to a value between 5 and 1440 minutes",
 			},
 			"refresh_token_lifetime_minutes": {
 				Type:     schema.TypeInt,
 				Optional: true,
+				// unlimited (0) or up to 5 years
+				ValidateDiagFunc: intBetween(0, 2628000),
+				Default:          0,
+				Description:      "Lifetime of refresh token. The refresh token never expires when it is set to 0",
 			},
 			"refresh_token_window_minutes": {
 				Type:     schema.TypeInt,
@@ -76,6 +81,7 @@ func resourceAuthServerPolicyRule() *schema.Resource {
 				// 5 minutes - 5 years
 				ValidateDiagFunc: intBetween(5, 2628000),
 				Default:          10080,
+				Description:      "Window in which a refresh token can be used, the refresh token expires if it isn't used within this window",
 			},
 			"inline_hook_id": {
 				Type:     schema.TypeString,
@@ -136,12 +142,14 @@ func resourceAuthServerPolicyRuleRead(ctx context.Context, d *schema.ResourceDat
 	_ = d.Set("status", authServerPolicyRule.Status)
 	_ = d.Set("priority", authServerPolicyRule.Priority)
 	_ = d.Set("type", authServerPolicyRule.Type)
-	_ = d.Set("access_token_lifetime_minutes", authServerPolicyRule.Actions.Token.AccessTokenLifetimeMinutes)
-	_ = d.Set("refresh_token_lifetime_minutes", authServerPolicyRule.Actions.Token.RefreshTokenLifetimeMinutes)
-	_ = d.Set("refresh_token_window_minutes", authServerPolicyRule.Actions.Token.RefreshTokenWindowMinutes)
-
-	if authServerPolicyRule.Actions.Token.InlineHook != nil {
-		_ = d.Set("inline_hook_id", authServerPolicyRule.Actions.Token.InlineHook.Id)
+	if authServerPolicyRule.Actions != nil && authServerPolicyRule.Actions.Token != nil {
+		token := authServerPolicyRule.Actions.Token
+		_ = d.Set("access_token_lifetime_minutes", token.AccessTokenLifetimeMinutes)
+		_ = d.Set("refresh_token_lifetime_minutes", token.RefreshTokenLifetimeMinutes)
+		_ = d.Set("refresh_token_window_minutes", token.RefreshTokenWindowMinutes)
+		if token.InlineHook != nil {
+			_ = d.Set("inline_hook_id", token.InlineHook.Id)
+		}
 	}
 	err = setNonPrimitives(d, map[string]interface{}{
 		"grant_type_whitelist": authServerPolicyRule.Conditions.GrantTypes.Include,
@@ -293,10 +301,11 @@ func validateAuthServerPolicyRule(d *schema.ResourceData) error {
 	rtlm := d.Get("refresh_token_lifetime_minutes").(int)
 	atlm := d.Get("access_token_lifetime_minutes").(int)
 	rtwm := d.Get("refresh_token_window_minutes").(int)
+	// refresh token with the unlimited lifetime (0) is only bound by the window
 	if rtlm > 0 && rtlm < atlm {
 		return errors.New("'refresh_token_lifetime_minutes' must be greater than or equal to 'access_token_lifetime_minutes'")
 	}
-	if rtlm > 0 && (atlm > rtwm || rtlm < rtwm) {
+	if atlm > rtwm || (rtlm > 0 && rtlm < rtwm) {
 		return errors.New("'refresh_token_window_minutes' must be between 'access_token_lifetime_minutes' and 'refresh_token_lifetime_minutes'")
 	}
 	return nil
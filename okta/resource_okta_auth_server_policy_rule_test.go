@@ -27,6 +27,7 @@ func TestAccOktaAuthServerPolicyRule_create(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
 					resource.TestCheckResourceAttr(resourceName, "name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_token_lifetime_minutes", "0"),
 				),
 			},
 			{
@@ -34,6 +35,8 @@ func TestAccOktaAuthServerPolicyRule_create(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
 					resource.TestCheckResourceAttr(resourceName, "name", "test_updated"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_token_lifetime_minutes", "43200"),
+					resource.TestCheckResourceAttr(resourceName, "refresh_token_window_minutes", "1440"),
 				),
 			},
 		},
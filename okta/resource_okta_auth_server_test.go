@@ -139,3 +139,83 @@ func TestAccOktaAuthServer_gh299(t *testing.T) {
 		},
 	})
 }
+
+func TestAccOktaAuthServer_keyRotation(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", authServer)
+	mgr := newFixtureManager(authServer)
+	config := mgr.GetFixtures("key_rotation.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("key_rotation_updated.tf", ri, t)
+	var kid string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(authServer, authServerExists),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, authServerExists),
+					resource.TestCheckResourceAttr(resourceName, "credentials_rotation_mode", "MANUAL"),
+					resource.TestCheckResourceAttrWith(resourceName, "kid", func(value string) error {
+						kid = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, authServerExists),
+					resource.TestCheckResourceAttr(resourceName, "credentials_rotation_trigger", "2"),
+					resource.TestCheckResourceAttrWith(resourceName, "kid", func(value string) error {
+						if value == kid {
+							return fmt.Errorf("expected the signing key '%s' to be rotated", kid)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOktaAuthServer_inactive(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := fmt.Sprintf("%s.test", authServer)
+	mgr := newFixtureManager(authServer)
+	config := mgr.GetFixtures("inactive.tf", ri, t)
+	activatedConfig := mgr.GetFixtures("inactive_activated.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(authServer, authServerExists),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, authServerExists),
+					resource.TestCheckResourceAttr(resourceName, "status", statusInactive),
+				),
+			},
+			{
+				Config: activatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, authServerExists),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+				),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, authServerExists),
+					resource.TestCheckResourceAttr(resourceName, "status", statusInactive),
+				),
+			},
+		},
+	})
+}
@@ -55,7 +55,7 @@ func resourceCaptchaCreate(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.Errorf("failed to create CAPTCHA: %v", err)
 	}
 	d.SetId(captcha.Id)
-	return nil
+	return resourceCaptchaRead(ctx, d, m)
 }
 
 func resourceCaptchaRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -86,7 +86,7 @@ func resourceCaptchaUpdate(ctx context.Context, d *schema.ResourceData, m interf
 	if err != nil {
 		return diag.Errorf("failed to update CAPTCHA: %v", err)
 	}
-	return nil
+	return resourceCaptchaRead(ctx, d, m)
 }
 
 func resourceCaptchaDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -95,8 +95,8 @@ func resourceCaptchaDelete(ctx context.Context, d *schema.ResourceData, m interf
 	}
 
 	logger(m).Info("deleting Captcha", "name", d.Get("name").(string))
-	_, err := getSupplementFromMetadata(m).DeleteCaptcha(ctx, d.Id())
-	if err != nil {
+	resp, err := getSupplementFromMetadata(m).DeleteCaptcha(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to delete CAPTCHA: %v", err)
 	}
 	return nil
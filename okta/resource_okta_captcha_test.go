@@ -37,6 +37,12 @@ func TestAccOktaCaptcha(t *testing.T) {
 						resource.TestCheckResourceAttr(resourceName, "type", "HCAPTCHA"),
 						resource.TestCheckResourceAttr(resourceName, "site_key", "random_key_updated")),
 				},
+				{
+					ResourceName:            resourceName,
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"secret_key"},
+				},
 			},
 		})
 }
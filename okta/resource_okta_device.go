@@ -0,0 +1,122 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceDevice() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDeviceCreate,
+		ReadContext:   resourceDeviceRead,
+		UpdateContext: resourceDeviceUpdate,
+		DeleteContext: resourceDeviceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "Manages the lifecycle of the registered device. Devices are registered by Okta Verify, so they can't be created",
+		Schema: map[string]*schema.Schema{
+			"device_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the registered device",
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: elemInSlice([]string{
+					sdk.DeviceStatusActive, sdk.DeviceStatusDeactivated, sdk.DeviceStatusSuspended,
+				}),
+				Description: "Status of the device: ACTIVE, DEACTIVATED or SUSPENDED",
+			},
+			"delete_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Deletes the device when the resource is destroyed, the device is deactivated first",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Display name of the device",
+			},
+			"platform": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Platform of the device",
+			},
+		},
+	}
+}
+
+func resourceDeviceCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	device, _, err := getSupplementFromMetadata(m).GetDevice(ctx, d.Get("device_id").(string))
+	if err != nil {
+		return diag.Errorf("failed to get device: %v", err)
+	}
+	err = changeDeviceStatus(ctx, m, device, d.Get("status").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(device.Id)
+	return resourceDeviceRead(ctx, d, m)
+}
+
+func resourceDeviceRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	device, resp, err := getSupplementFromMetadata(m).GetDevice(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get device: %v", err)
+	}
+	if device == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("device_id", device.Id)
+	_ = d.Set("status", device.Status)
+	if device.Profile != nil {
+		_ = d.Set("display_name", device.Profile.DisplayName)
+		_ = d.Set("platform", device.Profile.Platform)
+	}
+	return nil
+}
+
+func resourceDeviceUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.HasChange("status") {
+		return nil
+	}
+	device, _, err := getSupplementFromMetadata(m).GetDevice(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("failed to get device: %v", err)
+	}
+	err = changeDeviceStatus(ctx, m, device, d.Get("status").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceDeviceRead(ctx, d, m)
+}
+
+func resourceDeviceDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.Get("delete_on_destroy").(bool) {
+		return nil
+	}
+	device, resp, err := getSupplementFromMetadata(m).GetDevice(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get device: %v", err)
+	}
+	if device == nil {
+		return nil
+	}
+	err = changeDeviceStatus(ctx, m, device, sdk.DeviceStatusDeactivated)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, err = getSupplementFromMetadata(m).DeleteDevice(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete device: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package okta
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// TestAccOktaDevice_crud devices can't be registered by the provider, so the test requires an existing device
+//
+// OKTA_ACC_TEST_DEVICE_ID=<device id> TF_ACC=1 \
+// go test -tags unit -mod=readonly -test.v -run ^TestAccOktaDevice_crud$ ./okta 2>&1
+func TestAccOktaDevice_crud(t *testing.T) {
+	deviceID := os.Getenv("OKTA_ACC_TEST_DEVICE_ID")
+	if deviceID == "" {
+		t.Skip("OKTA_ACC_TEST_DEVICE_ID must be set to the ID of the registered device to run this test")
+	}
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(device)
+	config := strings.ReplaceAll(mgr.GetFixtures("basic.tf", ri, t), "replace_with_device_id", deviceID)
+	updated := strings.ReplaceAll(mgr.GetFixtures("basic_updated.tf", ri, t), "replace_with_device_id", deviceID)
+	resourceName := fmt.Sprintf("%s.test", device)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "device_id", deviceID),
+					resource.TestCheckResourceAttr(resourceName, "status", "SUSPENDED"),
+					resource.TestCheckResourceAttrSet(resourceName, "platform"),
+				),
+			},
+			{
+				Config: updated,
+				Check:  resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_on_destroy"},
+			},
+		},
+	})
+}
@@ -15,6 +15,14 @@ func resourceDomain() *schema.Resource {
 		ReadContext:   resourceDomainRead,
 		UpdateContext: resourceDomainUpdate,
 		DeleteContext: resourceDomainDelete,
+		// the certificate source type can't be updated, and replacing the domain would break its DNS verification
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+			if d.Id() != "" && d.HasChange("certificate_source_type") {
+				oldType, newType := d.GetChange("certificate_source_type")
+				return fmt.Errorf("'certificate_source_type' of the domain is '%s' and can't be changed to '%s', the domain has to be deleted and created again", oldType, newType)
+			}
+			return nil
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -26,10 +34,11 @@ func resourceDomain() *schema.Resource {
 				ForceNew:    true,
 			},
 			"certificate_source_type": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Optional. Certificate source type that indicates whether the certificate is provided by the user or Okta. Accepted values: MANUAL, OKTA_MANAGED. Warning: Use of OKTA_MANAGED requires a feature flag to be enabled. Default value = MANUAL",
-				Default:     "MANUAL",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Optional. Certificate source type that indicates whether the certificate is provided by the user or Okta. Accepted values: MANUAL, OKTA_MANAGED. Warning: Use of OKTA_MANAGED requires a feature flag to be enabled. Default value = MANUAL",
+				Default:          "MANUAL",
+				ValidateDiagFunc: elemInSlice([]string{"MANUAL", "OKTA_MANAGED"}),
 			},
 			"verify": {
 				Type:        schema.TypeBool,
@@ -43,6 +52,30 @@ func resourceDomain() *schema.Resource {
 				Computed:    true,
 				Description: "Status of the domain",
 			},
+			"public_certificate": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Metadata of the domain's TLS certificate",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expiration": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Certificate expiration",
+						},
+						"fingerprint": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Certificate fingerprint",
+						},
+						"subject": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Certificate subject",
+						},
+					},
+				},
+			},
 			"dns_records": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -106,7 +139,10 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, m interface
 		return diag.FromErr(err)
 	}
 
-	d.Set("name", domain.Domain)
+	_ = d.Set("name", domain.Domain)
+	if domain.CertificateSourceType != "" {
+		_ = d.Set("certificate_source_type", domain.CertificateSourceType)
+	}
 
 	if vd != nil {
 		_ = d.Set("validation_status", vd.ValidationStatus)
@@ -122,7 +158,20 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, m interface
 			"values":      convertStringSliceToInterfaceSlice(domain.DnsRecords[i].Values),
 		}
 	}
-	err = setNonPrimitives(d, map[string]interface{}{"dns_records": arr})
+	var cert []map[string]interface{}
+	if domain.PublicCertificate != nil {
+		cert = []map[string]interface{}{
+			{
+				"expiration":  domain.PublicCertificate.Expiration,
+				"fingerprint": domain.PublicCertificate.Fingerprint,
+				"subject":     domain.PublicCertificate.Subject,
+			},
+		}
+	}
+	err = setNonPrimitives(d, map[string]interface{}{
+		"dns_records":        arr,
+		"public_certificate": cert,
+	})
 	if err != nil {
 		return diag.Errorf("failed to set DNS records: %v", err)
 	}
@@ -141,8 +190,8 @@ func resourceDomainRead(ctx context.Context, d *schema.ResourceData, m interface
 
 func resourceDomainDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	logger(m).Info("deleting domain", "id", d.Id())
-	_, err := getOktaClientFromMetadata(m).Domain.DeleteDomain(ctx, d.Id())
-	if err != nil {
+	resp, err := getOktaClientFromMetadata(m).Domain.DeleteDomain(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to delete domain: %v", err)
 	}
 	return nil
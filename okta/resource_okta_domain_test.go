@@ -27,6 +27,8 @@ func TestAccOktaDomain(t *testing.T) {
 					ensureResourceExists(resourceName, domainExists),
 					resource.TestCheckResourceAttr(resourceName, "name", "example.com"),
 					resource.TestCheckResourceAttr(resourceName, "dns_records.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "certificate_source_type", "MANUAL"),
+					resource.TestCheckResourceAttr(resourceName, "public_certificate.#", "0"),
 				),
 			},
 		},
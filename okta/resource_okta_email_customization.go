@@ -2,6 +2,7 @@ package okta
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,7 +17,16 @@ func resourceEmailCustomization() *schema.Resource {
 		UpdateContext: resourceEmailCustomizationUpdate,
 		DeleteContext: resourceEmailCustomizationDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.Split(d.Id(), "/")
+				if len(parts) != 3 {
+					return nil, errors.New("invalid resource import specifier. Use: terraform import <brand_id>/<template_name>/<customization_id>")
+				}
+				_ = d.Set("brand_id", parts[0])
+				_ = d.Set("template_name", parts[1])
+				d.SetId(parts[2])
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 		Schema: emailCustomizationResourceSchema,
 	}
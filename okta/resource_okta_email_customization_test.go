@@ -2,6 +2,7 @@ package okta
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -61,6 +62,19 @@ func TestAccResourceOktaEmailCustomization_crud(t *testing.T) {
 					resource.TestCheckResourceAttr("okta_email_customization.forgot_password_es", "is_default", "true"),
 				),
 			},
+			{
+				ResourceName:            "okta_email_customization.forgot_password_es",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_is_default"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["okta_email_customization.forgot_password_es"]
+					if !ok {
+						return "", errors.New("failed to find okta_email_customization.forgot_password_es")
+					}
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["brand_id"], rs.Primary.Attributes["template_name"], rs.Primary.ID), nil
+				},
+			},
 		},
 	})
 }
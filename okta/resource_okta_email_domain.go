@@ -0,0 +1,149 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceEmailDomain() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEmailDomainCreate,
+		ReadContext:   resourceEmailDomainRead,
+		UpdateContext: resourceEmailDomainUpdate,
+		DeleteContext: resourceEmailDomainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"brand_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Brand ID",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Mail domain to send from",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of the email sender",
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "User name of the email sender, the part of the address before the '@' sign",
+			},
+			"validation_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Verification status of the email domain",
+			},
+			"dns_validation_records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "TXT and CNAME records to be registered for the email domain",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"expiration": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "TXT record expiration",
+						},
+						"fqdn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS record name",
+						},
+						"record_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Record type can be TXT or CNAME",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "DNS verification value",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceEmailDomainCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	domain, _, err := getSupplementFromMetadata(m).CreateEmailDomain(ctx, buildEmailDomain(d))
+	if err != nil {
+		return diag.Errorf("failed to create email domain: %v", err)
+	}
+	d.SetId(domain.ID)
+	return resourceEmailDomainRead(ctx, d, m)
+}
+
+func resourceEmailDomainRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	domain, resp, err := getSupplementFromMetadata(m).GetEmailDomain(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get email domain: %v", err)
+	}
+	if domain == nil || domain.ValidationStatus == "DELETED" {
+		d.SetId("")
+		return nil
+	}
+	// brand ID might be omitted in the response, keep the configured one in that case
+	if domain.BrandID != "" {
+		_ = d.Set("brand_id", domain.BrandID)
+	}
+	_ = d.Set("domain", domain.Domain)
+	_ = d.Set("display_name", domain.DisplayName)
+	_ = d.Set("user_name", domain.UserName)
+	_ = d.Set("validation_status", domain.ValidationStatus)
+	arr := make([]map[string]interface{}, len(domain.DNSValidationRecords))
+	for i := range domain.DNSValidationRecords {
+		arr[i] = map[string]interface{}{
+			"expiration":  domain.DNSValidationRecords[i].Expiration,
+			"fqdn":        domain.DNSValidationRecords[i].Fqdn,
+			"record_type": domain.DNSValidationRecords[i].RecordType,
+			"value":       domain.DNSValidationRecords[i].VerificationValue,
+		}
+	}
+	err = setNonPrimitives(d, map[string]interface{}{"dns_validation_records": arr})
+	if err != nil {
+		return diag.Errorf("failed to set DNS validation records: %v", err)
+	}
+	return nil
+}
+
+func resourceEmailDomainUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, _, err := getSupplementFromMetadata(m).UpdateEmailDomain(ctx, d.Id(), sdk.EmailDomainUpdate{
+		DisplayName: d.Get("display_name").(string),
+		UserName:    d.Get("user_name").(string),
+	})
+	if err != nil {
+		return diag.Errorf("failed to update email domain: %v", err)
+	}
+	return resourceEmailDomainRead(ctx, d, m)
+}
+
+func resourceEmailDomainDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).DeleteEmailDomain(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete email domain: %v", err)
+	}
+	return nil
+}
+
+func buildEmailDomain(d *schema.ResourceData) sdk.EmailDomain {
+	return sdk.EmailDomain{
+		BrandID:     d.Get("brand_id").(string),
+		Domain:      d.Get("domain").(string),
+		DisplayName: d.Get("display_name").(string),
+		UserName:    d.Get("user_name").(string),
+	}
+}
@@ -0,0 +1,54 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaEmailDomain_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(emailDomain)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", emailDomain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(emailDomain, emailDomainExists),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, emailDomainExists),
+					resource.TestCheckResourceAttr(resourceName, "domain", fmt.Sprintf("testacc-%d.example.com", ri)),
+					resource.TestCheckResourceAttr(resourceName, "display_name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "user_name", "no-reply"),
+					resource.TestCheckResourceAttr(resourceName, "validation_status", "NOT_STARTED"),
+					resource.TestCheckResourceAttrSet(resourceName, "dns_validation_records.0.fqdn"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, emailDomainExists),
+					resource.TestCheckResourceAttr(resourceName, "display_name", fmt.Sprintf("testAcc_updated_%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "user_name", "notifications"),
+				),
+			},
+		},
+	})
+}
+
+func emailDomainExists(id string) (bool, error) {
+	domain, resp, err := getSupplementFromMetadata(testAccProvider.Meta()).GetEmailDomain(context.Background(), id)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return false, err
+	}
+	return domain != nil && domain.ValidationStatus != "DELETED", nil
+}
@@ -0,0 +1,49 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceEmailDomainVerification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEmailDomainVerificationCreate,
+		ReadContext:   resourceFuncNoOp,
+		DeleteContext: resourceFuncNoOp,
+		Importer:      nil,
+		Schema: map[string]*schema.Schema{
+			"email_domain_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Email domain ID",
+			},
+		},
+	}
+}
+
+func resourceEmailDomainVerificationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bOff := backoff.NewExponentialBackOff()
+	bOff.MaxElapsedTime = time.Second * 30
+	bOff.InitialInterval = time.Second
+	err := backoff.Retry(func() error {
+		domain, _, err := getSupplementFromMetadata(m).VerifyEmailDomain(ctx, d.Get("email_domain_id").(string))
+		if err != nil {
+			return backoff.Permanent(fmt.Errorf("failed to verify email domain: %v", err))
+		}
+		if domain.ValidationStatus != "VERIFIED" {
+			return fmt.Errorf("failed to verify email domain after several attempts, current validation status: %s", domain.ValidationStatus)
+		}
+		return nil
+	}, bOff)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(d.Get("email_domain_id").(string))
+	return nil
+}
@@ -2,13 +2,33 @@ package okta
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
+const eventHookVerified = "VERIFIED"
+
+var eventHookFilterSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"event": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Event type to filter, it must be one of the event hook events",
+		},
+		"expression": {
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateDiagFunc: stringIsOktaExpression,
+			Description:      "Okta Expression Language condition the event has to match to be delivered",
+		},
+	},
+}
+
 func resourceEventHook() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceEventHookCreate,
@@ -24,19 +44,37 @@ func resourceEventHook() *schema.Resource {
 				Required: true,
 			},
 			"status": statusSchema,
+			"verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to verify the event hook on creation and whenever its channel changes, the endpoint has to respond to the verification request",
+			},
+			"verification_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Verification status of the event hook: VERIFIED or UNVERIFIED",
+			},
 			"events": {
 				Type:     schema.TypeSet,
 				Required: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"filter": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Filters of the event hook deliveries, at most one filter per event type",
+				Elem:        eventHookFilterSchema,
+			},
 			"headers": {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Elem:     headerSchema,
 			},
 			"auth": {
-				Type:     schema.TypeMap,
-				Optional: true,
+				Type:          schema.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"oauth"},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -66,6 +104,7 @@ func resourceEventHook() *schema.Resource {
 					return errs
 				},
 			},
+			"oauth": hookOAuthSchema,
 			"channel": {
 				Type:     schema.TypeMap,
 				Required: true,
@@ -82,7 +121,7 @@ func resourceEventHook() *schema.Resource {
 					var errs diag.Diagnostics
 					m := i.(map[string]interface{})
 					if t, ok := m["type"]; ok {
-						dErr := elemInSlice([]string{"HTTP"})(t, cty.GetAttrPath("type"))
+						dErr := elemInSlice([]string{"HTTP", sdk.HookChannelTypeOAuth})(t, cty.GetAttrPath("type"))
 						if dErr != nil {
 							errs = append(errs, dErr...)
 						}
@@ -104,12 +143,20 @@ func resourceEventHook() *schema.Resource {
 
 func resourceEventHookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
-	hook := buildEventHook(d)
-	newHook, _, err := client.EventHook.CreateEventHook(ctx, *hook)
+	hook, err := buildEventHook(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	newHook, _, err := getSupplementFromMetadata(m).CreateEventHook(ctx, *hook)
 	if err != nil {
 		return diag.Errorf("failed to create event hook: %v", err)
 	}
 	d.SetId(newHook.Id)
+	if d.Get("verify").(bool) {
+		if dErr := verifyEventHook(ctx, d, client); dErr != nil {
+			return dErr
+		}
+	}
 	err = setEventHookStatus(ctx, d, client, newHook.Status)
 	if err != nil {
 		return diag.Errorf("failed to set event hook status: %v", err)
@@ -118,7 +165,7 @@ func resourceEventHookCreate(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceEventHookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	hook, resp, err := getOktaClientFromMetadata(m).EventHook.GetEventHook(ctx, d.Id())
+	hook, resp, err := getSupplementFromMetadata(m).GetEventHook(ctx, d.Id())
 	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to get event hook: %v", err)
 	}
@@ -128,11 +175,18 @@ func resourceEventHookRead(ctx context.Context, d *schema.ResourceData, m interf
 	}
 	_ = d.Set("name", hook.Name)
 	_ = d.Set("status", hook.Status)
-	_ = d.Set("events", eventSet(hook.Events))
+	_ = d.Set("verification_status", hook.VerificationStatus)
+	var filter *sdk.EventHookFilter
+	if hook.Events != nil {
+		_ = d.Set("events", eventSet(&okta.EventSubscriptions{Type: hook.Events.Type, Items: hook.Events.Items}))
+		filter = hook.Events.Filter
+	}
 	err = setNonPrimitives(d, map[string]interface{}{
+		"filter":  flattenEventHookFilter(filter),
 		"channel": flattenEventHookChannel(hook.Channel),
 		"headers": flattenEventHookHeaders(hook.Channel),
 		"auth":    flattenEventHookAuth(d, hook.Channel),
+		"oauth":   flattenHookOAuth(d, hook.Channel.Type, hook.Channel.Config.HookChannelOAuthConfig),
 	})
 	if err != nil {
 		return diag.Errorf("failed to set event hook properties: %v", err)
@@ -142,11 +196,20 @@ func resourceEventHookRead(ctx context.Context, d *schema.ResourceData, m interf
 
 func resourceEventHookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
-	hook := buildEventHook(d)
-	newHook, _, err := client.EventHook.UpdateEventHook(ctx, d.Id(), *hook)
+	hook, err := buildEventHook(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	newHook, _, err := getSupplementFromMetadata(m).UpdateEventHook(ctx, d.Id(), *hook)
 	if err != nil {
 		return diag.Errorf("failed to update auth event hook: %v", err)
 	}
+	// changing the channel resets the verification of the event hook
+	if d.Get("verify").(bool) && (d.HasChanges("channel", "verify") || newHook.VerificationStatus != eventHookVerified) {
+		if dErr := verifyEventHook(ctx, d, client); dErr != nil {
+			return dErr
+		}
+	}
 	err = setEventHookStatus(ctx, d, client, newHook.Status)
 	if err != nil {
 		return diag.Errorf("failed to set event hook status: %v", err)
@@ -168,21 +231,71 @@ func resourceEventHookDelete(ctx context.Context, d *schema.ResourceData, m inte
 	return nil
 }
 
-func buildEventHook(d *schema.ResourceData) *okta.EventHook {
+func buildEventHook(d *schema.ResourceData) (*sdk.EventHook, error) {
 	eventSet := d.Get("events").(*schema.Set).List()
 	events := make([]string, len(eventSet))
 	for i, v := range eventSet {
 		events[i] = v.(string)
 	}
-	return &okta.EventHook{
-		Name:    d.Get("name").(string),
-		Status:  d.Get("status").(string),
-		Events:  &okta.EventSubscriptions{Type: "EVENT_TYPE", Items: events},
-		Channel: buildEventChannel(d),
+	filter, err := buildEventHookFilter(d, events)
+	if err != nil {
+		return nil, err
+	}
+	channel, err := buildEventChannel(d)
+	if err != nil {
+		return nil, err
+	}
+	return &sdk.EventHook{
+		EventHook: okta.EventHook{
+			Name:   d.Get("name").(string),
+			Status: d.Get("status").(string),
+		},
+		Channel: channel,
+		Events:  &sdk.EventHookEvents{Type: "EVENT_TYPE", Items: events, Filter: filter},
+	}, nil
+}
+
+func buildEventHookFilter(d *schema.ResourceData, events []string) (*sdk.EventHookFilter, error) {
+	raw := d.Get("filter").(*schema.Set).List()
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	filter := &sdk.EventHookFilter{Type: "EXPRESSION_LANGUAGE"}
+	filtered := make(map[string]bool, len(raw))
+	for _, v := range raw {
+		f := v.(map[string]interface{})
+		event := f["event"].(string)
+		if !contains(events, event) {
+			return nil, fmt.Errorf("filtered event '%s' must be one of the event hook 'events'", event)
+		}
+		if filtered[event] {
+			return nil, fmt.Errorf("event '%s' can only be filtered once", event)
+		}
+		filtered[event] = true
+		filter.EventFilterMap = append(filter.EventFilterMap, &sdk.EventHookFilterEvent{
+			Event:     event,
+			Condition: &sdk.EventHookFilterCondition{Expression: f["expression"].(string)},
+		})
+	}
+	return filter, nil
+}
+
+func flattenEventHookFilter(filter *sdk.EventHookFilter) *schema.Set {
+	if filter == nil || len(filter.EventFilterMap) == 0 {
+		return nil
+	}
+	arr := make([]interface{}, 0, len(filter.EventFilterMap))
+	for _, f := range filter.EventFilterMap {
+		m := map[string]interface{}{"event": f.Event}
+		if f.Condition != nil {
+			m["expression"] = f.Condition.Expression
+		}
+		arr = append(arr, m)
 	}
+	return schema.NewSet(schema.HashResource(eventHookFilterSchema), arr)
 }
 
-func buildEventChannel(d *schema.ResourceData) *okta.EventHookChannel {
+func buildEventChannel(d *schema.ResourceData) (*sdk.EventHookChannel, error) {
 	var headerList []*okta.EventHookChannelConfigHeader
 	if raw, ok := d.GetOk("headers"); ok {
 		for _, header := range raw.(*schema.Set).List() {
@@ -206,22 +319,26 @@ func buildEventChannel(d *schema.ResourceData) *okta.EventHookChannel {
 		}
 	}
 	rawChannel := d.Get("channel").(map[string]interface{})
-	_, ok := rawChannel["type"]
-	if !ok {
-		rawChannel["type"] = "HTTP"
-	}
-	return &okta.EventHookChannel{
-		Config: &okta.EventHookChannelConfig{
-			Uri:        rawChannel["uri"].(string),
-			AuthScheme: auth,
-			Headers:    headerList,
+	channelType, _ := rawChannel["type"].(string)
+	oauth, channelType, err := buildHookOAuth(d, channelType)
+	if err != nil {
+		return nil, err
+	}
+	return &sdk.EventHookChannel{
+		Config: &sdk.EventHookChannelConfig{
+			EventHookChannelConfig: okta.EventHookChannelConfig{
+				Uri:        rawChannel["uri"].(string),
+				AuthScheme: auth,
+				Headers:    headerList,
+			},
+			HookChannelOAuthConfig: oauth,
 		},
-		Type:    rawChannel["type"].(string),
+		Type:    channelType,
 		Version: rawChannel["version"].(string),
-	}
+	}, nil
 }
 
-func flattenEventHookAuth(d *schema.ResourceData, c *okta.EventHookChannel) map[string]interface{} {
+func flattenEventHookAuth(d *schema.ResourceData, c *sdk.EventHookChannel) map[string]interface{} {
 	auth := map[string]interface{}{}
 	if c.Config.AuthScheme != nil {
 		auth = map[string]interface{}{
@@ -233,7 +350,7 @@ func flattenEventHookAuth(d *schema.ResourceData, c *okta.EventHookChannel) map[
 	return auth
 }
 
-func flattenEventHookChannel(c *okta.EventHookChannel) map[string]interface{} {
+func flattenEventHookChannel(c *sdk.EventHookChannel) map[string]interface{} {
 	return map[string]interface{}{
 		"type":    c.Type,
 		"version": c.Version,
@@ -241,7 +358,7 @@ func flattenEventHookChannel(c *okta.EventHookChannel) map[string]interface{} {
 	}
 }
 
-func flattenEventHookHeaders(c *okta.EventHookChannel) *schema.Set {
+func flattenEventHookHeaders(c *sdk.EventHookChannel) *schema.Set {
 	headers := make([]interface{}, len(c.Config.Headers))
 	for i, header := range c.Config.Headers {
 		headers[i] = map[string]interface{}{
@@ -260,6 +377,14 @@ func eventSet(e *okta.EventSubscriptions) *schema.Set {
 	return schema.NewSet(schema.HashString, events)
 }
 
+func verifyEventHook(ctx context.Context, d *schema.ResourceData, client *okta.Client) diag.Diagnostics {
+	_, _, err := client.EventHook.VerifyEventHook(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("failed to verify event hook sender: %v", err)
+	}
+	return nil
+}
+
 func setEventHookStatus(ctx context.Context, d *schema.ResourceData, client *okta.Client, status string) error {
 	desiredStatus := d.Get("status").(string)
 	if status == desiredStatus {
@@ -33,6 +33,7 @@ func TestAccOktaEventHook_crud(t *testing.T) {
 					ensureResourceExists(resourceName, eventHookExists),
 					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
 					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "verification_status", "UNVERIFIED"),
 					resource.TestCheckResourceAttr(resourceName, "channel.type", "HTTP"),
 					resource.TestCheckResourceAttr(resourceName, "channel.version", "1.0.0"),
 					resource.TestCheckResourceAttr(resourceName, "channel.uri", "https://example.com/test"),
@@ -57,6 +58,7 @@ func TestAccOktaEventHook_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "channel.type", "HTTP"),
 					resource.TestCheckResourceAttr(resourceName, "channel.version", "1.0.0"),
 					resource.TestCheckResourceAttr(resourceName, "channel.uri", "https://example.com/testUpdated"),
+					resource.TestCheckResourceAttr(resourceName, "filter.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "auth.type", "HEADER"),
 					resource.TestCheckResourceAttr(resourceName, "auth.key", "Authorization"),
 					testCheckResourceSetAttr(
@@ -113,6 +115,34 @@ func TestAccOktaEventHook_crud(t *testing.T) {
 	})
 }
 
+func TestAccOktaEventHook_oauth(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := "okta_event_hook.test"
+	mgr := newFixtureManager(eventHook)
+	config := mgr.GetFixtures("oauth.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(eventHook, eventHookExists),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, eventHookExists),
+					resource.TestCheckResourceAttr(resourceName, "channel.type", "OAUTH"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.0.auth_type", "client_secret_post"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.0.client_id", "abc123"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.0.token_url", "https://example.com/oauth2/v1/token"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.0.scope", "api"),
+				),
+			},
+		},
+	})
+}
+
 func eventHookExists(id string) (bool, error) {
 	eh, resp, err := getOktaClientFromMetadata(testAccProvider.Meta()).EventHook.GetEventHook(context.Background(), id)
 	if err := suppressErrorOn404(resp, err); err != nil {
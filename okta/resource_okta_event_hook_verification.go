@@ -10,7 +10,7 @@ import (
 func resourceEventHookVerification() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceEventHookVerificationCreate,
-		ReadContext:   resourceFuncNoOp,
+		ReadContext:   resourceEventHookVerificationRead,
 		DeleteContext: resourceFuncNoOp,
 		Importer:      nil,
 		Schema: map[string]*schema.Schema{
@@ -32,3 +32,16 @@ func resourceEventHookVerificationCreate(ctx context.Context, d *schema.Resource
 	d.SetId(d.Get("event_hook_id").(string))
 	return nil
 }
+
+// resourceEventHookVerificationRead the verification is removed from the state when the event hook is removed or
+// its verification is reset, e.g. by changing its channel, so the event hook is verified again on the next apply
+func resourceEventHookVerificationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	hook, resp, err := getOktaClientFromMetadata(m).EventHook.GetEventHook(ctx, d.Get("event_hook_id").(string))
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get event hook: %v", err)
+	}
+	if hook == nil || hook.VerificationStatus != eventHookVerified {
+		d.SetId("")
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package okta
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+func resourceFeature() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFeatureCreate,
+		ReadContext:   resourceFeatureRead,
+		UpdateContext: resourceFeatureUpdate,
+		DeleteContext: resourceFuncNoOp,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				_ = d.Set("feature_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Description: "Enables or disables self-service Early Access feature of the organization. The feature keeps its status when the resource is destroyed",
+		Schema: map[string]*schema.Schema{
+			"feature_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the feature",
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          featureEnabled,
+				ValidateDiagFunc: elemInSlice([]string{featureEnabled, featureDisabled}),
+				Description:      "Status of the feature: ENABLED or DISABLED",
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables the features the feature depends on, or disables the features that depend on it, when the status is changed",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the feature",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Description of the feature",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the feature",
+			},
+			"stage_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the release stage: OPEN or CLOSED",
+			},
+			"stage_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Release stage of the feature: EA or BETA",
+			},
+		},
+	}
+}
+
+func resourceFeatureCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId(d.Get("feature_id").(string))
+	err := handleFeatureLifecycle(ctx, d, m)
+	if err != nil {
+		d.SetId("")
+		return err
+	}
+	return resourceFeatureRead(ctx, d, m)
+}
+
+func resourceFeatureRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	feature, resp, err := getOktaClientFromMetadata(m).Feature.GetFeature(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get feature: %v", err)
+	}
+	if feature == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("feature_id", feature.Id)
+	for k, v := range flattenFeature(feature) {
+		_ = d.Set(k, v)
+	}
+	return nil
+}
+
+func resourceFeatureUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChange("status") {
+		err := handleFeatureLifecycle(ctx, d, m)
+		if err != nil {
+			return err
+		}
+	}
+	return resourceFeatureRead(ctx, d, m)
+}
+
+// handleFeatureLifecycle changes the status of the feature only when it differs from the current one
+func handleFeatureLifecycle(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getOktaClientFromMetadata(m)
+	feature, _, err := client.Feature.GetFeature(ctx, d.Id())
+	if err != nil {
+		return diag.Errorf("failed to get feature: %v", err)
+	}
+	status := d.Get("status").(string)
+	if feature.Status == status {
+		return nil
+	}
+	var qp *query.Params
+	if d.Get("force").(bool) {
+		qp = &query.Params{Mode: "force"}
+	}
+	lifecycle := "enable"
+	if status == featureDisabled {
+		lifecycle = "disable"
+	}
+	_, _, err = client.Feature.UpdateFeatureLifecycle(ctx, d.Id(), lifecycle, qp)
+	if err != nil {
+		return diag.Errorf("failed to %s feature '%s': %v", lifecycle, strings.TrimSpace(feature.Name), err)
+	}
+	return nil
+}
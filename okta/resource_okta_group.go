@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
 )
 
 func resourceGroup() *schema.Resource {
@@ -23,21 +24,7 @@ func resourceGroup() *schema.Resource {
 		UpdateContext: resourceGroupUpdate,
 		DeleteContext: resourceGroupDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-				importID := strings.Split(d.Id(), "/")
-				if len(importID) == 1 {
-					return []*schema.ResourceData{d}, nil
-				}
-				if len(importID) > 2 {
-					return nil, errors.New("invalid format used for import ID, format must be 'group_id' or 'group_id/skip_users'")
-				}
-				d.SetId(importID[0])
-				if !isValidSkipArg(importID[1]) {
-					return nil, fmt.Errorf("'%s' is invalid value to be used as part of import ID, it can only be 'skip_users'", importID[1])
-				}
-				_ = d.Set(importID[1], true)
-				return []*schema.ResourceData{d}, nil
-			},
+			StateContext: resourceGroupImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -89,6 +76,68 @@ func resourceGroup() *schema.Resource {
 	}
 }
 
+// groupImportNamePrefix prefix of the import ID to look up the group by its name instead of its ID
+const groupImportNamePrefix = "name/"
+
+func resourceGroupImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	importID := d.Id()
+	if strings.HasSuffix(importID, "/skip_users") {
+		importID = strings.TrimSuffix(importID, "/skip_users")
+		_ = d.Set("skip_users", true)
+	}
+	var g *okta.Group
+	var err error
+	if strings.HasPrefix(importID, groupImportNamePrefix) {
+		g, err = findGroupByExactName(ctx, m, strings.TrimPrefix(importID, groupImportNamePrefix))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		parts := strings.Split(importID, "/")
+		if len(parts) > 1 {
+			if len(parts) == 2 && !isValidSkipArg(parts[1]) {
+				return nil, fmt.Errorf("'%s' is invalid value to be used as part of import ID, it can only be 'skip_users'", parts[1])
+			}
+			return nil, errors.New("invalid format used for import ID, format must be 'group_id', 'name/<group name>' or one of them followed by '/skip_users'")
+		}
+		g, _, err = getOktaClientFromMetadata(m).Group.GetGroup(ctx, importID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get group: %v", err)
+		}
+	}
+	// APP_GROUP and BUILT_IN groups are managed by Okta and can't be updated or deleted
+	if g.Type != "OKTA_GROUP" {
+		return nil, fmt.Errorf("group '%s' of the '%s' type can not be imported, only 'OKTA_GROUP' groups can be managed", g.Id, g.Type)
+	}
+	d.SetId(g.Id)
+	return []*schema.ResourceData{d}, nil
+}
+
+// findGroupByExactName the API query matches the groups which name starts with the given value,
+// so the exact match has to be done in the provider
+func findGroupByExactName(ctx context.Context, m interface{}, name string) (*okta.Group, error) {
+	groups, err := listGroups(ctx, getOktaClientFromMetadata(m), &query.Params{Q: name, Limit: defaultPaginationLimit})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list groups: %v", err)
+	}
+	var matches []*okta.Group
+	var ids []string
+	for _, g := range groups {
+		if g.Profile != nil && g.Profile.Name == name {
+			matches = append(matches, g)
+			ids = append(ids, g.Id)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("group with name '%s' does not exist", name)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("found %d groups with name '%s' (%s), import the group by its ID instead", len(matches), name, strings.Join(ids, ", "))
+	}
+}
+
 func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	logger(m).Info("creating group", "name", d.Get("name").(string))
 	group := buildGroup(d)
@@ -144,6 +193,8 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{
 		}
 		customProfileStr := string(customProfile)
 		_ = d.Set("custom_profile_attributes", customProfileStr)
+	} else {
+		_ = d.Set("custom_profile_attributes", "")
 	}
 
 	err = syncGroupUsers(ctx, d, m, skipUsers)
@@ -172,8 +223,8 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 
 func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	logger(m).Info("deleting group", "id", d.Id(), "name", d.Get("name").(string))
-	_, err := getOktaClientFromMetadata(m).Group.DeleteGroup(ctx, d.Id())
-	if err != nil {
+	resp, err := getOktaClientFromMetadata(m).Group.DeleteGroup(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to delete group: %v", err)
 	}
 	return nil
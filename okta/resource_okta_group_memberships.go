@@ -19,7 +19,15 @@ func resourceGroupMemberships() *schema.Resource {
 		UpdateContext: resourceGroupMembershipsUpdate,
 		DeleteContext: resourceGroupMembershipsDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+				userIDs, err := listGroupUserIDs(ctx, m, d.Id())
+				if err != nil {
+					return nil, fmt.Errorf("failed to list group users: %v", err)
+				}
+				_ = d.Set("group_id", d.Id())
+				_ = d.Set("users", convertStringSliceToSet(userIDs))
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 		Description: "Resource to manage a set of group memberships for a specific group.",
 		Schema: map[string]*schema.Schema{
@@ -54,7 +62,7 @@ func resourceGroupMembershipsCreate(ctx context.Context, d *schema.ResourceData,
 		d.SetId(groupId)
 		return nil
 	}
-	err := addGroupMembers(ctx, client, groupId, users)
+	err := reconcileGroupMembers(ctx, m, groupId, users, nil, false)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -89,6 +97,15 @@ func resourceGroupMembershipsRead(ctx context.Context, d *schema.ResourceData, m
 	oldUsers := convertInterfaceToStringSetNullable(d.Get("users"))
 	trackAllUsers := d.Get("track_all_users").(bool)
 
+	g, resp, err := client.Group.GetGroup(ctx, groupId)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get group: %v", err)
+	}
+	if g == nil {
+		d.SetId("")
+		return nil
+	}
+
 	// New behavior, tracking all users.
 	if trackAllUsers {
 		changed, newUserIDs, err := checkIfUsersHaveChanged(ctx, client, groupId, &oldUsers)
@@ -118,8 +135,20 @@ func resourceGroupMembershipsRead(ctx context.Context, d *schema.ResourceData, m
 func resourceGroupMembershipsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	groupId := d.Get("group_id").(string)
 	users := convertInterfaceToStringSetNullable(d.Get("users"))
-	client := getOktaClientFromMetadata(m)
-	err := removeGroupMembers(ctx, client, groupId, users)
+	groupUsers, resp, err := listGroupUsers(ctx, m, groupId)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to list users of group (%s): %v", groupId, err)
+	}
+	// the group is deleted along with its memberships, or it has no users left
+	if groupUsers == nil {
+		return nil
+	}
+	actual := make([]string, len(groupUsers))
+	for i, user := range groupUsers {
+		actual[i] = user.Id
+	}
+	_, toRemove := groupMembersDelta(nil, users, actual, false)
+	err = updateGroupMembersInParallel(ctx, m, groupId, nil, toRemove)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -128,26 +157,16 @@ func resourceGroupMembershipsDelete(ctx context.Context, d *schema.ResourceData,
 
 func resourceGroupMembershipsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	groupId := d.Get("group_id").(string)
-	client := getOktaClientFromMetadata(m)
 
 	oldUsers, newUsers := d.GetChange("users")
 
-	oldSet := oldUsers.(*schema.Set)
-	newSet := newUsers.(*schema.Set)
-
-	usersToAdd := convertInterfaceArrToStringArr(newSet.Difference(oldSet).List())
-	usersToRemove := convertInterfaceArrToStringArr(oldSet.Difference(newSet).List())
-
-	err := addGroupMembers(ctx, client, groupId, usersToAdd)
-	if err != nil {
-		diag.FromErr(err)
-	}
+	desired := convertInterfaceArrToStringArr(newUsers.(*schema.Set).List())
+	managed := convertInterfaceArrToStringArr(oldUsers.(*schema.Set).List())
 
-	err = removeGroupMembers(ctx, client, groupId, usersToRemove)
+	err := reconcileGroupMembers(ctx, m, groupId, desired, managed, d.Get("track_all_users").(bool))
 	if err != nil {
-		diag.FromErr(err)
+		return diag.FromErr(err)
 	}
-
 	return nil
 }
 
@@ -157,9 +176,6 @@ func resourceGroupMembershipsUpdate(ctx context.Context, d *schema.ResourceData,
 // slice of returned strings will be empty.
 func checkIfUsersHaveChanged(ctx context.Context, client *okta.Client, groupId string, users *[]string) (bool, *[]string, error) {
 	noop := []string{}
-	if users == nil || len(*users) == 0 {
-		return false, &noop, nil
-	}
 
 	// We are using the old users map as a ledger to find users that have been
 	// removed from the user list.
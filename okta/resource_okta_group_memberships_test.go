@@ -30,6 +30,12 @@ func TestAccResourceOktaGroupMemberships_crud(t *testing.T) {
 			{
 				Config: update,
 			},
+			{
+				ResourceName:            "okta_group_memberships.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"track_all_users"},
+			},
 			{
 				Config: remove,
 			},
@@ -0,0 +1,119 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceGroupOwner() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGroupOwnerCreate,
+		ReadContext:   resourceGroupOwnerRead,
+		DeleteContext: resourceGroupOwnerDelete,
+		Importer:      createNestedResourceImporter([]string{"group_id", "id"}),
+		Description:   "Resource to manage an owner of a group",
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the group",
+				ForceNew:    true,
+			},
+			"id_of_group_owner": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the user or the group which is the owner of the group",
+				ForceNew:    true,
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Type of the owner",
+				ForceNew:         true,
+				ValidateDiagFunc: elemInSlice([]string{"USER", "GROUP"}),
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Display name of the owner",
+			},
+			"origin_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Source where the owner is mastered",
+			},
+			"resolved": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the owner has been resolved in Okta",
+			},
+		},
+	}
+}
+
+func resourceGroupOwnerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	body := sdk.GroupOwner{
+		ID:   d.Get("id_of_group_owner").(string),
+		Type: d.Get("type").(string),
+	}
+	owner, _, err := getSupplementFromMetadata(m).AssignGroupOwner(ctx, d.Get("group_id").(string), body)
+	if err != nil {
+		return diag.Errorf("failed to assign group owner: %v", err)
+	}
+	d.SetId(owner.ID)
+	return resourceGroupOwnerRead(ctx, d, m)
+}
+
+func resourceGroupOwnerRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	owner, err := findGroupOwner(ctx, m, d.Get("group_id").(string), d.Id())
+	if err != nil {
+		return diag.Errorf("failed to get group owner: %v", err)
+	}
+	if owner == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("id_of_group_owner", owner.ID)
+	_ = d.Set("type", owner.Type)
+	_ = d.Set("display_name", owner.DisplayName)
+	_ = d.Set("origin_type", owner.OriginType)
+	if owner.Resolved != nil {
+		_ = d.Set("resolved", *owner.Resolved)
+	}
+	return nil
+}
+
+func resourceGroupOwnerDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).DeleteGroupOwner(ctx, d.Get("group_id").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete group owner: %v", err)
+	}
+	return nil
+}
+
+// findGroupOwner there is no API to get a single owner of the group, so all the owners are listed
+func findGroupOwner(ctx context.Context, m interface{}, groupID, ownerID string) (*sdk.GroupOwner, error) {
+	owners, resp, err := getSupplementFromMetadata(m).ListGroupOwners(ctx, groupID, &query.Params{Limit: defaultPaginationLimit})
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return nil, err
+	}
+	for {
+		for _, owner := range owners {
+			if owner.ID == ownerID {
+				return owner, nil
+			}
+		}
+		if resp == nil || !resp.HasNextPage() {
+			return nil, nil
+		}
+		owners = nil
+		resp, err = resp.Next(ctx, &owners)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
@@ -22,6 +22,7 @@ func resourceGroupRole() *schema.Resource {
 		DeleteContext: resourceGroupRoleDelete,
 		Importer:      createNestedResourceImporter([]string{"group_id", "id"}),
 		CustomizeDiff: customdiff.All(
+			validateGroupRoleTargets,
 			customdiff.ForceNewIf("target_group_list", func(_ context.Context, d *schema.ResourceDiff, m interface{}) bool {
 				if d.HasChange("target_group_list") {
 					// to avoid exception when removing last group target from a role assignment,
@@ -371,6 +372,21 @@ func findRole(ctx context.Context, d *schema.ResourceData, m interface{}) (*okta
 	return nil, nil
 }
 
+// validateGroupRoleTargets targets which aren't supported by the role would be silently ignored by the API
+func validateGroupRoleTargets(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	roleType := d.Get("role_type").(string)
+	if roleType == "CUSTOM" {
+		return fmt.Errorf("'CUSTOM' roles can not be assigned with this resource, use '%s' with the group as a member instead", adminRoleCustomAssignments)
+	}
+	if len(convertInterfaceToStringSet(d.Get("target_group_list"))) > 0 && !supportsGroupTargets(roleType) {
+		return fmt.Errorf("'target_group_list' is not supported for the '%s' role type", roleType)
+	}
+	if len(convertInterfaceToStringSet(d.Get("target_app_list"))) > 0 && roleType != "APP_ADMIN" {
+		return fmt.Errorf("'target_app_list' is only supported for the 'APP_ADMIN' role type")
+	}
+	return nil
+}
+
 func supportsGroupTargets(roleType string) bool {
 	return contains([]string{"GROUP_MEMBERSHIP_ADMIN", "HELP_DESK_ADMIN", "USER_ADMIN"}, roleType)
 }
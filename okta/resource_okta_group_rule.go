@@ -41,8 +41,9 @@ func resourceGroupRule() *schema.Resource {
 				Optional: true,
 			},
 			"expression_value": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: stringIsOktaExpression,
 			},
 			"status": statusSchema,
 			"remove_assigned_users": {
@@ -114,37 +115,38 @@ func resourceGroupRuleRead(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 func resourceGroupRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getOktaClientFromMetadata(m)
 	desiredStatus := d.Get("status").(string)
-	// Only inactive rules can be changed, thus we should handle this first
-	if d.HasChange("status") {
-		err := handleGroupRuleLifecycle(ctx, d, m)
-		if err != nil {
-			return diag.Errorf("failed to change group rule status: %v", err)
-		}
-		_ = d.Set("status", desiredStatus)
-	}
+	oldStatus, _ := d.GetChange("status")
 	// invalid group rules can not be updated
 	if hasGroupRuleChange(d) && desiredStatus != statusInvalid {
-		client := getOktaClientFromMetadata(m)
-		rule := buildGroupRule(d)
-		if desiredStatus == statusActive {
-			// Only inactive rules can be changed, thus we should deactivate the rule in case it was "ACTIVE"
+		// Only inactive rules can be changed, thus the rule is deactivated for the time of the update
+		// and activated afterwards, so the rule keeps its ID instead of being recreated
+		if oldStatus.(string) == statusActive {
 			_, err := client.Group.DeactivateGroupRule(ctx, d.Id())
 			if err != nil {
 				return diag.Errorf("failed to deactivate group rule: %v", err)
 			}
 		}
-		_, _, err := client.Group.UpdateGroupRule(ctx, d.Id(), *rule)
+		_, _, err := client.Group.UpdateGroupRule(ctx, d.Id(), *buildGroupRule(d))
 		if err != nil {
+			if oldStatus.(string) == statusActive {
+				// don't leave the rule deactivated in case the update has failed
+				_, _ = client.Group.ActivateGroupRule(ctx, d.Id())
+			}
 			return diag.Errorf("failed to update group rule: %v", err)
 		}
 		if desiredStatus == statusActive {
-			// We should reactivate the rule in case it was deactivated.
 			_, err := client.Group.ActivateGroupRule(ctx, d.Id())
 			if err != nil {
 				return diag.Errorf("failed to activate group rule: %v", err)
 			}
 		}
+	} else if d.HasChange("status") {
+		err := handleGroupRuleLifecycle(ctx, d, m)
+		if err != nil {
+			return diag.Errorf("failed to change group rule status: %v", err)
+		}
 	}
 	return resourceGroupRuleRead(ctx, d, m)
 }
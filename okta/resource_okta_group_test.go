@@ -34,6 +34,11 @@ func TestAccOktaGroup_crud(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", "testAccDifferent")),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: addUsersConfig,
 				Check: resource.ComposeTestCheckFunc(
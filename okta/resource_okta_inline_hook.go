@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 var headerSchema = &schema.Resource{
@@ -64,8 +65,9 @@ func resourceInlineHook() *schema.Resource {
 				Elem:     headerSchema,
 			},
 			"auth": {
-				Type:     schema.TypeMap,
-				Optional: true,
+				Type:          schema.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"oauth"},
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -93,6 +95,7 @@ func resourceInlineHook() *schema.Resource {
 					return errs
 				},
 			},
+			"oauth": hookOAuthSchema,
 			"channel": {
 				Type:     schema.TypeMap,
 				Required: true,
@@ -112,7 +115,7 @@ func resourceInlineHook() *schema.Resource {
 					var errs diag.Diagnostics
 					m := i.(map[string]interface{})
 					if t, ok := m["type"]; ok {
-						dErr := elemInSlice([]string{"HTTP"})(t, cty.GetAttrPath("type"))
+						dErr := elemInSlice([]string{"HTTP", sdk.HookChannelTypeOAuth})(t, cty.GetAttrPath("type"))
 						if dErr != nil {
 							errs = append(errs, dErr...)
 						}
@@ -145,8 +148,11 @@ func resourceInlineHook() *schema.Resource {
 }
 
 func resourceInlineHookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	hook := buildInlineHook(d)
-	newHook, _, err := getOktaClientFromMetadata(m).InlineHook.CreateInlineHook(ctx, hook)
+	hook, err := buildInlineHook(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	newHook, _, err := getSupplementFromMetadata(m).CreateInlineHook(ctx, hook)
 	if err != nil {
 		return diag.Errorf("failed to create inline hook: %v", err)
 	}
@@ -159,7 +165,7 @@ func resourceInlineHookCreate(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func resourceInlineHookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	hook, resp, err := getOktaClientFromMetadata(m).InlineHook.GetInlineHook(ctx, d.Id())
+	hook, resp, err := getSupplementFromMetadata(m).GetInlineHook(ctx, d.Id())
 	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to get inline hook: %v", err)
 	}
@@ -175,6 +181,7 @@ func resourceInlineHookRead(ctx context.Context, d *schema.ResourceData, m inter
 		"channel": flattenInlineHookChannel(hook.Channel),
 		"headers": flattenInlineHookHeaders(hook.Channel),
 		"auth":    flattenInlineHookAuth(d, hook.Channel),
+		"oauth":   flattenHookOAuth(d, hook.Channel.Type, hook.Channel.Config.HookChannelOAuthConfig),
 	})
 	if err != nil {
 		return diag.Errorf("failed to set inline hook properties: %v", err)
@@ -184,8 +191,11 @@ func resourceInlineHookRead(ctx context.Context, d *schema.ResourceData, m inter
 
 func resourceInlineHookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := getOktaClientFromMetadata(m)
-	hook := buildInlineHook(d)
-	newHook, _, err := client.InlineHook.UpdateInlineHook(ctx, d.Id(), hook)
+	hook, err := buildInlineHook(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	newHook, _, err := getSupplementFromMetadata(m).UpdateInlineHook(ctx, d.Id(), hook)
 	if err != nil {
 		return diag.Errorf("failed to update inline hook: %v", err)
 	}
@@ -209,17 +219,23 @@ func resourceInlineHookDelete(ctx context.Context, d *schema.ResourceData, m int
 	return nil
 }
 
-func buildInlineHook(d *schema.ResourceData) okta.InlineHook {
-	return okta.InlineHook{
-		Name:    d.Get("name").(string),
-		Status:  d.Get("status").(string),
-		Type:    d.Get("type").(string),
-		Version: d.Get("version").(string),
-		Channel: buildInlineChannel(d),
+func buildInlineHook(d *schema.ResourceData) (sdk.InlineHook, error) {
+	channel, err := buildInlineChannel(d)
+	if err != nil {
+		return sdk.InlineHook{}, err
 	}
+	return sdk.InlineHook{
+		InlineHook: okta.InlineHook{
+			Name:    d.Get("name").(string),
+			Status:  d.Get("status").(string),
+			Type:    d.Get("type").(string),
+			Version: d.Get("version").(string),
+		},
+		Channel: channel,
+	}, nil
 }
 
-func buildInlineChannel(d *schema.ResourceData) *okta.InlineHookChannel {
+func buildInlineChannel(d *schema.ResourceData) (*sdk.InlineHookChannel, error) {
 	var headerList []*okta.InlineHookChannelConfigHeaders
 	if raw, ok := d.GetOk("headers"); ok {
 		for _, header := range raw.(*schema.Set).List() {
@@ -252,23 +268,27 @@ func buildInlineChannel(d *schema.ResourceData) *okta.InlineHookChannel {
 	if !ok {
 		rawChannel["method"] = "POST"
 	}
-	_, ok = rawChannel["type"]
-	if !ok {
-		rawChannel["type"] = "HTTP"
+	channelType, _ := rawChannel["type"].(string)
+	oauth, channelType, err := buildHookOAuth(d, channelType)
+	if err != nil {
+		return nil, err
 	}
-	return &okta.InlineHookChannel{
-		Config: &okta.InlineHookChannelConfig{
-			Uri:        rawChannel["uri"].(string),
-			AuthScheme: auth,
-			Headers:    headerList,
-			Method:     rawChannel["method"].(string),
+	return &sdk.InlineHookChannel{
+		Config: &sdk.InlineHookChannelConfig{
+			InlineHookChannelConfig: okta.InlineHookChannelConfig{
+				Uri:        rawChannel["uri"].(string),
+				AuthScheme: auth,
+				Headers:    headerList,
+				Method:     rawChannel["method"].(string),
+			},
+			HookChannelOAuthConfig: oauth,
 		},
-		Type:    rawChannel["type"].(string),
+		Type:    channelType,
 		Version: rawChannel["version"].(string),
-	}
+	}, nil
 }
 
-func flattenInlineHookAuth(d *schema.ResourceData, c *okta.InlineHookChannel) map[string]interface{} {
+func flattenInlineHookAuth(d *schema.ResourceData, c *sdk.InlineHookChannel) map[string]interface{} {
 	auth := map[string]interface{}{}
 	if c.Config.AuthScheme != nil {
 		auth = map[string]interface{}{
@@ -281,7 +301,7 @@ func flattenInlineHookAuth(d *schema.ResourceData, c *okta.InlineHookChannel) ma
 	return auth
 }
 
-func flattenInlineHookChannel(c *okta.InlineHookChannel) map[string]interface{} {
+func flattenInlineHookChannel(c *sdk.InlineHookChannel) map[string]interface{} {
 	return map[string]interface{}{
 		"type":    c.Type,
 		"version": c.Version,
@@ -290,7 +310,7 @@ func flattenInlineHookChannel(c *okta.InlineHookChannel) map[string]interface{}
 	}
 }
 
-func flattenInlineHookHeaders(c *okta.InlineHookChannel) *schema.Set {
+func flattenInlineHookHeaders(c *sdk.InlineHookChannel) *schema.Set {
 	headers := make([]interface{}, len(c.Config.Headers))
 	for i, header := range c.Config.Headers {
 		headers[i] = map[string]interface{}{
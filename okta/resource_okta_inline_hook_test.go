@@ -103,6 +103,34 @@ func TestAccOktaInlineHook_crud(t *testing.T) {
 	})
 }
 
+func TestAccOktaInlineHook_oauth(t *testing.T) {
+	ri := acctest.RandInt()
+	resourceName := "okta_inline_hook.test"
+	mgr := newFixtureManager(inlineHook)
+	config := mgr.GetFixtures("oauth.tf", ri, t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(inlineHook, inlineHookExists),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, inlineHookExists),
+					resource.TestCheckResourceAttr(resourceName, "channel.type", "OAUTH"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.0.auth_type", "client_secret_post"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.0.client_id", "abc123"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.0.token_url", "https://example.com/oauth2/v1/token"),
+					resource.TestCheckResourceAttr(resourceName, "oauth.0.scope", "api"),
+				),
+			},
+		},
+	})
+}
+
 func inlineHookExists(id string) (bool, error) {
 	_, resp, err := getOktaClientFromMetadata(testAccProvider.Meta()).InlineHook.GetInlineHook(context.Background(), id)
 	if err := suppressErrorOn404(resp, err); err != nil {
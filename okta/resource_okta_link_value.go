@@ -59,7 +59,7 @@ func resourceLinkValueCreate(ctx context.Context, d *schema.ResourceData, m inte
 				"associatedUser: %s, primaryName: %s, primaryUser: %s, err: %v", associatedUser, lo.Primary.Name, puID, err)
 		}
 	}
-	return nil
+	return resourceLinkValueRead(ctx, d, m)
 }
 
 func resourceLinkValueRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -87,6 +87,19 @@ func resourceLinkValueRead(ctx context.Context, d *schema.ResourceData, m interf
 	for i := range los {
 		ids[i] = path.Base(linksValue(los[i].Links, "self", "href"))
 	}
+	// users can be referenced by their logins, so those are kept in the state as long as they resolve to the linked users
+	for _, u := range convertInterfaceToStringSetNullable(d.Get("associated_user_ids")) {
+		if contains(ids, u) {
+			continue
+		}
+		user, resp, err := client.User.GetUser(ctx, u)
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return diag.Errorf("failed to get associated user: %v", err)
+		}
+		if user != nil && contains(ids, user.Id) {
+			ids = append(remove(ids, user.Id), u)
+		}
+	}
 	_ = d.Set("associated_user_ids", convertStringSliceToSet(ids))
 	return nil
 }
@@ -110,7 +123,7 @@ func resourceLinkValueUpdate(ctx context.Context, d *schema.ResourceData, m inte
 			return diag.Errorf("failed to remove relationship: associatedUser: %s, primaryName: %s, err: %v", u, d.Get("primary_name"), err)
 		}
 	}
-	return nil
+	return resourceLinkValueRead(ctx, d, m)
 }
 
 func resourceLinkValueDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
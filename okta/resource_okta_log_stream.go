@@ -0,0 +1,230 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+var eventSourceNameRegex = regexp.MustCompile(`^[\.\-_A-Za-z0-9]{1,75}$`)
+
+func resourceLogStream() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLogStreamCreate,
+		ReadContext:   resourceLogStreamRead,
+		UpdateContext: resourceLogStreamUpdate,
+		DeleteContext: resourceLogStreamDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "Manages log stream, which sends the System Log events to AWS EventBridge or Splunk Cloud",
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique name of the log stream",
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: elemInSlice([]string{sdk.LogStreamTypeAWSEventBridge, sdk.LogStreamTypeSplunkCloud}),
+				Description:      "Type of the log stream: aws_eventbridge or splunk_cloud_logstreaming",
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          statusActive,
+				ValidateDiagFunc: elemInSlice([]string{statusActive, statusInactive}),
+				Description:      "Log stream status - can either be ACTIVE or INACTIVE only",
+			},
+			"settings": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Log stream settings, the set of the properties depends on the log stream type",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "AWS account ID, required for the aws_eventbridge type",
+						},
+						"event_source_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							ValidateDiagFunc: func(i interface{}, k cty.Path) diag.Diagnostics {
+								return stringMatches(i, k, eventSourceNameRegex, "event source name")
+							},
+							Description: "Name of the event source in AWS EventBridge, required for the aws_eventbridge type",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "AWS region, required for the aws_eventbridge type",
+						},
+						"edition": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: elemInSlice([]string{"aws", "gcp", "aws_govcloud"}),
+							Description:      "Edition of the Splunk Cloud instance: aws, gcp or aws_govcloud, required for the splunk_cloud_logstreaming type",
+						},
+						"host": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Host of the Splunk Cloud instance without the scheme, e.g. acme.splunkcloud.com, required for the splunk_cloud_logstreaming type",
+						},
+						"token": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "HEC token of the Splunk Cloud instance, required for the splunk_cloud_logstreaming type",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceLogStreamCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logStream, err := buildLogStream(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	newLogStream, _, err := getSupplementFromMetadata(m).CreateLogStream(ctx, logStream)
+	if err != nil {
+		return diag.Errorf("failed to create log stream: %v", err)
+	}
+	d.SetId(newLogStream.Id)
+	// log streams are always created active
+	if d.Get("status").(string) != statusActive {
+		err = handleLogStreamLifecycle(ctx, d, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceLogStreamRead(ctx, d, m)
+}
+
+func resourceLogStreamRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logStream, resp, err := getSupplementFromMetadata(m).GetLogStream(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get log stream: %v", err)
+	}
+	if logStream == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("name", logStream.Name)
+	_ = d.Set("type", logStream.Type)
+	_ = d.Set("status", logStream.Status)
+	err = setNonPrimitives(d, map[string]interface{}{
+		"settings": flattenLogStreamSettings(d, logStream.Settings),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set log stream settings: %v", err)
+	}
+	return nil
+}
+
+func resourceLogStreamUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	logStream, err := buildLogStream(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, _, err = getSupplementFromMetadata(m).UpdateLogStream(ctx, d.Id(), logStream)
+	if err != nil {
+		return diag.Errorf("failed to update log stream: %v", err)
+	}
+	if d.HasChange("status") {
+		err = handleLogStreamLifecycle(ctx, d, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceLogStreamRead(ctx, d, m)
+}
+
+func resourceLogStreamDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getSupplementFromMetadata(m).DeleteLogStream(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete log stream: %v", err)
+	}
+	return nil
+}
+
+func handleLogStreamLifecycle(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	if d.Get("status").(string) == statusActive {
+		_, err := getSupplementFromMetadata(m).ActivateLogStream(ctx, d.Id())
+		if err != nil {
+			return fmt.Errorf("failed to activate log stream: %v", err)
+		}
+		return nil
+	}
+	_, err := getSupplementFromMetadata(m).DeactivateLogStream(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("failed to deactivate log stream: %v", err)
+	}
+	return nil
+}
+
+func buildLogStream(d *schema.ResourceData) (sdk.LogStream, error) {
+	logStream := sdk.LogStream{
+		Name: d.Get("name").(string),
+		Type: d.Get("type").(string),
+	}
+	settings := &sdk.LogStreamSettings{}
+	if raw, ok := d.GetOk("settings.0"); ok {
+		s := raw.(map[string]interface{})
+		settings.AccountId = s["account_id"].(string)
+		settings.EventSourceName = s["event_source_name"].(string)
+		settings.Region = s["region"].(string)
+		settings.Edition = s["edition"].(string)
+		settings.Host = s["host"].(string)
+		settings.Token = s["token"].(string)
+	}
+	switch logStream.Type {
+	case sdk.LogStreamTypeAWSEventBridge:
+		if settings.AccountId == "" || settings.EventSourceName == "" || settings.Region == "" {
+			return logStream, fmt.Errorf("'account_id', 'event_source_name' and 'region' settings are required for the '%s' log stream", logStream.Type)
+		}
+		if settings.Edition != "" || settings.Host != "" || settings.Token != "" {
+			return logStream, fmt.Errorf("'edition', 'host' and 'token' settings are not supported by the '%s' log stream", logStream.Type)
+		}
+	case sdk.LogStreamTypeSplunkCloud:
+		if settings.Edition == "" || settings.Host == "" || settings.Token == "" {
+			return logStream, fmt.Errorf("'edition', 'host' and 'token' settings are required for the '%s' log stream", logStream.Type)
+		}
+		if settings.AccountId != "" || settings.EventSourceName != "" || settings.Region != "" {
+			return logStream, fmt.Errorf("'account_id', 'event_source_name' and 'region' settings are not supported by the '%s' log stream", logStream.Type)
+		}
+	}
+	logStream.Settings = settings
+	return logStream, nil
+}
+
+func flattenLogStreamSettings(d *schema.ResourceData, settings *sdk.LogStreamSettings) []interface{} {
+	if settings == nil {
+		return nil
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"account_id":        settings.AccountId,
+			"event_source_name": settings.EventSourceName,
+			"region":            settings.Region,
+			"edition":           settings.Edition,
+			"host":              settings.Host,
+			// Read only
+			"token": d.Get("settings.0.token"),
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaLogStream_awsEventBridge(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(logStream)
+	config := mgr.GetFixtures("aws_eventbridge.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("aws_eventbridge_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", logStream)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(logStream, doesLogStreamExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesLogStreamExist),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "type", "aws_eventbridge"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.account_id", "123456789012"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.event_source_name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.region", "us-east-1"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesLogStreamExist),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("testAcc_updated_%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "status", statusInactive),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccOktaLogStream_splunk(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(logStream)
+	config := mgr.GetFixtures("splunk.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", logStream)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(logStream, doesLogStreamExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesLogStreamExist),
+					resource.TestCheckResourceAttr(resourceName, "type", "splunk_cloud_logstreaming"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.edition", "aws"),
+					resource.TestCheckResourceAttr(resourceName, "settings.0.host", "acme.splunkcloud.com"),
+				),
+			},
+		},
+	})
+}
+
+func doesLogStreamExist(id string) (bool, error) {
+	_, response, err := getSupplementFromMetadata(testAccProvider.Meta()).GetLogStream(context.Background(), id)
+	return doesResourceExist(response, err)
+}
@@ -2,6 +2,7 @@ package okta
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -24,7 +25,7 @@ func resourceNetworkZone() *schema.Resource {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Description: "Array of locations ISO-3166-1(2). Format code: countryCode OR countryCode-regionCode",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: stringIsLocation},
 			},
 			"dynamic_proxy_type": {
 				Type:             schema.TypeString,
@@ -66,7 +67,14 @@ func resourceNetworkZone() *schema.Resource {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				Description: "Format of each array value: a string representation of an ASN numeric value",
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: stringIsASN},
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          statusActive,
+				ValidateDiagFunc: elemInSlice([]string{statusActive, statusInactive}),
+				Description:      "Network Status - can either be ACTIVE or INACTIVE only",
 			},
 		},
 	}
@@ -82,6 +90,13 @@ func resourceNetworkZoneCreate(ctx context.Context, d *schema.ResourceData, m in
 		return diag.Errorf("failed to create network zone: %v", err)
 	}
 	d.SetId(zone.Id)
+	// zones are always created active
+	if d.Get("status").(string) != statusActive {
+		err = handleNetworkZoneLifecycle(ctx, d, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	return resourceNetworkZoneRead(ctx, d, m)
 }
 
@@ -97,6 +112,7 @@ func resourceNetworkZoneRead(ctx context.Context, d *schema.ResourceData, m inte
 	_ = d.Set("name", zone.Name)
 	_ = d.Set("type", zone.Type)
 	_ = d.Set("usage", zone.Usage)
+	_ = d.Set("status", zone.Status)
 	_ = d.Set("dynamic_proxy_type", zone.ProxyType)
 	_ = d.Set("asns", convertStringSliceToSetNullable(zone.Asns))
 	err = setNonPrimitives(d, map[string]interface{}{
@@ -119,9 +135,31 @@ func resourceNetworkZoneUpdate(ctx context.Context, d *schema.ResourceData, m in
 	if err != nil {
 		return diag.Errorf("failed to update network zone: %v", err)
 	}
+	if d.HasChange("status") {
+		err = handleNetworkZoneLifecycle(ctx, d, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	return resourceNetworkZoneRead(ctx, d, m)
 }
 
+func handleNetworkZoneLifecycle(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	client := getOktaClientFromMetadata(m)
+	if d.Get("status").(string) == statusActive {
+		_, _, err := client.NetworkZone.ActivateNetworkZone(ctx, d.Id())
+		if err != nil {
+			return fmt.Errorf("failed to activate network zone: %v", err)
+		}
+		return nil
+	}
+	_, _, err := client.NetworkZone.DeactivateNetworkZone(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("failed to deactivate network zone: %v", err)
+	}
+	return nil
+}
+
 func resourceNetworkZoneDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	resp, err := getOktaClientFromMetadata(m).NetworkZone.DeleteNetworkZone(ctx, d.Id())
 	if err := suppressErrorOn404(resp, err); err != nil {
@@ -144,13 +182,7 @@ func buildNetworkZone(d *schema.ResourceData) okta.NetworkZone {
 			proxiesList = buildAddressObjList(values.(*schema.Set))
 		}
 	} else if values, ok := d.GetOk("dynamic_locations"); ok {
-		for _, value := range values.(*schema.Set).List() {
-			if strings.Contains(value.(string), "-") {
-				locationsList = append(locationsList, &okta.NetworkZoneLocation{Country: strings.Split(value.(string), "-")[0], Region: value.(string)})
-			} else {
-				locationsList = append(locationsList, &okta.NetworkZoneLocation{Country: value.(string)})
-			}
-		}
+		locationsList = buildNetworkZoneLocations(values.(*schema.Set))
 	}
 
 	return okta.NetworkZone{
@@ -165,6 +197,19 @@ func buildNetworkZone(d *schema.ResourceData) okta.NetworkZone {
 	}
 }
 
+// buildNetworkZoneLocations the region of the location is the full "countryCode-regionCode" code
+func buildNetworkZoneLocations(values *schema.Set) []*okta.NetworkZoneLocation {
+	var locationsList []*okta.NetworkZoneLocation
+	for _, value := range values.List() {
+		if strings.Contains(value.(string), "-") {
+			locationsList = append(locationsList, &okta.NetworkZoneLocation{Country: strings.Split(value.(string), "-")[0], Region: value.(string)})
+		} else {
+			locationsList = append(locationsList, &okta.NetworkZoneLocation{Country: value.(string)})
+		}
+	}
+	return locationsList
+}
+
 func buildAddressObjList(values *schema.Set) []*okta.NetworkZoneAddress {
 	var addressType string
 	var addressObjList []*okta.NetworkZoneAddress
@@ -210,5 +255,24 @@ func validateNetworkZone(d *schema.ResourceData) error {
 	if d.Get("usage").(string) != "POLICY" && ok && proxies.(*schema.Set).Len() != 0 {
 		return fmt.Errorf(`zones with usage = "BLOCKLIST" cannot have trusted proxies`)
 	}
+	if d.Get("type").(string) == "IP" {
+		for _, k := range []string{"dynamic_locations", "dynamic_proxy_type", "asns"} {
+			if _, ok := d.GetOk(k); ok {
+				return fmt.Errorf(`'%s' can only be set for zones with type = "DYNAMIC"`, k)
+			}
+		}
+	} else {
+		for _, k := range []string{"gateways", "proxies"} {
+			if _, ok := d.GetOk(k); ok {
+				return fmt.Errorf(`'%s' can only be set for zones with type = "IP"`, k)
+			}
+		}
+		_, okLocations := d.GetOk("dynamic_locations")
+		_, okASNs := d.GetOk("asns")
+		_, okProxyType := d.GetOk("dynamic_proxy_type")
+		if !okLocations && !okASNs && !okProxyType {
+			return errors.New(`at least one of 'dynamic_locations', 'asns' or 'dynamic_proxy_type' should be set for zones with type = "DYNAMIC"`)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,216 @@
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourceNetworkZoneEnhancedDynamic() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNetworkZoneEnhancedDynamicCreate,
+		ReadContext:   resourceNetworkZoneEnhancedDynamicRead,
+		UpdateContext: resourceNetworkZoneEnhancedDynamicUpdate,
+		DeleteContext: resourceNetworkZoneEnhancedDynamicDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "Manages enhanced dynamic (DYNAMIC_V2) network zone, which matches the requests by the locations, " +
+			"ASNs and IP service categories (e.g. VPNs and proxies) they are coming from",
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the Network Zone Resource",
+			},
+			"usage": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Zone's purpose: POLICY or BLOCKLIST",
+				ValidateDiagFunc: elemInSlice([]string{"POLICY", "BLOCKLIST"}),
+				Default:          "POLICY",
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          statusActive,
+				ValidateDiagFunc: elemInSlice([]string{statusActive, statusInactive}),
+				Description:      "Network Status - can either be ACTIVE or INACTIVE only",
+			},
+			"locations_include": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Array of locations ISO-3166-1(2) to include. Format code: countryCode OR countryCode-regionCode",
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: stringIsLocation},
+			},
+			"locations_exclude": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Array of locations ISO-3166-1(2) to exclude. Format code: countryCode OR countryCode-regionCode",
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: stringIsLocation},
+			},
+			"asns_include": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Array of ASNs to include. Format of each array value: a string representation of an ASN numeric value",
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: stringIsASN},
+			},
+			"asns_exclude": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Array of ASNs to exclude. Format of each array value: a string representation of an ASN numeric value",
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateDiagFunc: stringIsASN},
+			},
+			"ip_service_categories_include": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Array of IP service categories to include, e.g. ALL_ANONYMIZERS or ALL_ANY_PROXIES",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ip_service_categories_exclude": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Array of IP service categories to exclude",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceNetworkZoneEnhancedDynamicCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := validateNetworkZoneEnhancedDynamic(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	zone, _, err := getSupplementFromMetadata(m).CreateEnhancedDynamicNetworkZone(ctx, buildNetworkZoneEnhancedDynamic(d))
+	if err != nil {
+		return diag.Errorf("failed to create enhanced dynamic network zone: %v", err)
+	}
+	d.SetId(zone.Id)
+	// zones are always created active
+	if d.Get("status").(string) != statusActive {
+		err = handleNetworkZoneEnhancedDynamicLifecycle(ctx, d, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceNetworkZoneEnhancedDynamicRead(ctx, d, m)
+}
+
+func resourceNetworkZoneEnhancedDynamicRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	zone, resp, err := getSupplementFromMetadata(m).GetEnhancedDynamicNetworkZone(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get enhanced dynamic network zone: %v", err)
+	}
+	if zone == nil {
+		d.SetId("")
+		return nil
+	}
+	if zone.Type != sdk.EnhancedDynamicNetworkZoneType {
+		return diag.Errorf("network zone '%s' is of type '%s', only '%s' zones are supported, use the 'okta_network_zone' resource instead",
+			d.Id(), zone.Type, sdk.EnhancedDynamicNetworkZoneType)
+	}
+	_ = d.Set("name", zone.Name)
+	_ = d.Set("usage", zone.Usage)
+	_ = d.Set("status", zone.Status)
+	attrs := map[string]interface{}{
+		"locations_include":             nil,
+		"locations_exclude":             nil,
+		"asns_include":                  nil,
+		"asns_exclude":                  nil,
+		"ip_service_categories_include": nil,
+		"ip_service_categories_exclude": nil,
+	}
+	if zone.Locations != nil {
+		attrs["locations_include"] = flattenDynamicLocations(zone.Locations.Include)
+		attrs["locations_exclude"] = flattenDynamicLocations(zone.Locations.Exclude)
+	}
+	if zone.Asns != nil {
+		attrs["asns_include"] = convertStringSliceToSetNullable(zone.Asns.Include)
+		attrs["asns_exclude"] = convertStringSliceToSetNullable(zone.Asns.Exclude)
+	}
+	if zone.IpServiceCategories != nil {
+		attrs["ip_service_categories_include"] = convertStringSliceToSetNullable(zone.IpServiceCategories.Include)
+		attrs["ip_service_categories_exclude"] = convertStringSliceToSetNullable(zone.IpServiceCategories.Exclude)
+	}
+	err = setNonPrimitives(d, attrs)
+	if err != nil {
+		return diag.Errorf("failed to set enhanced dynamic network zone properties: %v", err)
+	}
+	return nil
+}
+
+func resourceNetworkZoneEnhancedDynamicUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	err := validateNetworkZoneEnhancedDynamic(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, _, err = getSupplementFromMetadata(m).UpdateEnhancedDynamicNetworkZone(ctx, d.Id(), buildNetworkZoneEnhancedDynamic(d))
+	if err != nil {
+		return diag.Errorf("failed to update enhanced dynamic network zone: %v", err)
+	}
+	if d.HasChange("status") {
+		err = handleNetworkZoneEnhancedDynamicLifecycle(ctx, d, m)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceNetworkZoneEnhancedDynamicRead(ctx, d, m)
+}
+
+func resourceNetworkZoneEnhancedDynamicDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getOktaClientFromMetadata(m).NetworkZone.DeleteNetworkZone(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete enhanced dynamic network zone: %v", err)
+	}
+	return nil
+}
+
+func handleNetworkZoneEnhancedDynamicLifecycle(ctx context.Context, d *schema.ResourceData, m interface{}) error {
+	if d.Get("status").(string) == statusActive {
+		_, err := getSupplementFromMetadata(m).ActivateEnhancedDynamicNetworkZone(ctx, d.Id())
+		if err != nil {
+			return fmt.Errorf("failed to activate enhanced dynamic network zone: %v", err)
+		}
+		return nil
+	}
+	_, err := getSupplementFromMetadata(m).DeactivateEnhancedDynamicNetworkZone(ctx, d.Id())
+	if err != nil {
+		return fmt.Errorf("failed to deactivate enhanced dynamic network zone: %v", err)
+	}
+	return nil
+}
+
+func buildNetworkZoneEnhancedDynamic(d *schema.ResourceData) sdk.EnhancedDynamicNetworkZone {
+	return sdk.EnhancedDynamicNetworkZone{
+		Name:   d.Get("name").(string),
+		Type:   sdk.EnhancedDynamicNetworkZoneType,
+		Usage:  d.Get("usage").(string),
+		Status: d.Get("status").(string),
+		Locations: &sdk.EnhancedDynamicNetworkZoneLocations{
+			Include: buildNetworkZoneLocations(d.Get("locations_include").(*schema.Set)),
+			Exclude: buildNetworkZoneLocations(d.Get("locations_exclude").(*schema.Set)),
+		},
+		Asns: &sdk.EnhancedDynamicNetworkZoneCondition{
+			Include: convertInterfaceToStringSetNullable(d.Get("asns_include")),
+			Exclude: convertInterfaceToStringSetNullable(d.Get("asns_exclude")),
+		},
+		IpServiceCategories: &sdk.EnhancedDynamicNetworkZoneCondition{
+			Include: convertInterfaceToStringSetNullable(d.Get("ip_service_categories_include")),
+			Exclude: convertInterfaceToStringSetNullable(d.Get("ip_service_categories_exclude")),
+		},
+	}
+}
+
+func validateNetworkZoneEnhancedDynamic(d *schema.ResourceData) error {
+	for _, k := range []string{"locations_include", "locations_exclude", "asns_include", "asns_exclude", "ip_service_categories_include", "ip_service_categories_exclude"} {
+		if _, ok := d.GetOk(k); ok {
+			return nil
+		}
+	}
+	return errors.New("at least one of the locations, ASNs or IP service categories should be included or excluded")
+}
@@ -0,0 +1,58 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaNetworkZoneEnhancedDynamic_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(networkZoneEnhancedDynamic)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", networkZoneEnhancedDynamic)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(networkZoneEnhancedDynamic, doesNetworkZoneEnhancedDynamicExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "usage", "BLOCKLIST"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "ip_service_categories_include.#", "1"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("testAcc_%d Updated", ri)),
+					resource.TestCheckResourceAttr(resourceName, "usage", "POLICY"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusInactive),
+					resource.TestCheckResourceAttr(resourceName, "locations_include.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "asns_include.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ip_service_categories_include.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ip_service_categories_exclude.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func doesNetworkZoneEnhancedDynamicExist(id string) (bool, error) {
+	_, response, err := getSupplementFromMetadata(testAccProvider.Meta()).GetEnhancedDynamicNetworkZone(context.Background(), id)
+	return doesResourceExist(response, err)
+}
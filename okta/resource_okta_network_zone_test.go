@@ -31,6 +31,7 @@ func TestAccOktaNetworkZone_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "proxies.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "gateways.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "usage", "POLICY"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
 					resource.TestCheckResourceAttr(dynamicResourceName, "name", fmt.Sprintf("testAcc_%d Dynamic", ri)),
 					resource.TestCheckResourceAttr(dynamicResourceName, "type", "DYNAMIC"),
 					resource.TestCheckResourceAttr(dynamicResourceName, "dynamic_locations.#", "2"),
@@ -44,6 +45,7 @@ func TestAccOktaNetworkZone_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "proxies.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "gateways.#", "2"),
 					resource.TestCheckResourceAttr(resourceName, "usage", "BLOCKLIST"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusInactive),
 					resource.TestCheckResourceAttr(dynamicResourceName, "name", fmt.Sprintf("testAcc_%d Dynamic Updated", ri)),
 					resource.TestCheckResourceAttr(dynamicResourceName, "type", "DYNAMIC"),
 					resource.TestCheckResourceAttr(dynamicResourceName, "dynamic_locations.#", "3"),
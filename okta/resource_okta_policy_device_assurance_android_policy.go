@@ -0,0 +1,121 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourcePolicyDeviceAssuranceAndroid() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyDeviceAssuranceAndroidCreate,
+		ReadContext:   resourcePolicyDeviceAssuranceAndroidRead,
+		UpdateContext: resourcePolicyDeviceAssuranceAndroidUpdate,
+		DeleteContext: resourcePolicyDeviceAssuranceAndroidDelete,
+		Importer:      createDeviceAssuranceImporter(sdk.DeviceAssurancePlatformAndroid),
+		Description:   "Manages device assurance policy for Android devices",
+		Schema: buildDeviceAssuranceSchema(map[string]*schema.Schema{
+			"disk_encryption_type": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of the disk encryption types, that are considered secure: FULL, USER",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: elemInSlice([]string{"FULL", "USER"}),
+				},
+			},
+			"jailbreak": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the rooted devices are allowed. Set to `false` to require devices that are not rooted",
+			},
+			"screen_lock_type": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of the screen lock types, that are considered secure: PASSCODE, BIOMETRIC",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: elemInSlice([]string{"PASSCODE", "BIOMETRIC"}),
+				},
+			},
+			"secure_hardware_present": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the device is required to have a hardware-backed keystore",
+			},
+		}),
+	}
+}
+
+func resourcePolicyDeviceAssuranceAndroidCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceAndroid)
+	}
+	da, _, err := getSupplementFromMetadata(m).CreateDeviceAssurance(ctx, buildDeviceAssuranceAndroid(d))
+	if err != nil {
+		return diag.Errorf("failed to create android device assurance policy: %v", err)
+	}
+	d.SetId(da.Id)
+	return resourcePolicyDeviceAssuranceAndroidRead(ctx, d, m)
+}
+
+func resourcePolicyDeviceAssuranceAndroidRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceAndroid)
+	}
+	da, err := getDeviceAssurance(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to get android device assurance policy: %v", err)
+	}
+	if da == nil {
+		d.SetId("")
+		return nil
+	}
+	syncDeviceAssurance(d, da)
+	if da.Jailbreak != nil {
+		_ = d.Set("jailbreak", *da.Jailbreak)
+	}
+	if da.SecureHardwarePresent != nil {
+		_ = d.Set("secure_hardware_present", *da.SecureHardwarePresent)
+	}
+	err = setNonPrimitives(d, map[string]interface{}{
+		"disk_encryption_type": flattenDeviceAssuranceInclude(da.DiskEncryptionType),
+		"screen_lock_type":     flattenDeviceAssuranceInclude(da.ScreenLockType),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set android device assurance policy properties: %v", err)
+	}
+	return nil
+}
+
+func resourcePolicyDeviceAssuranceAndroidUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceAndroid)
+	}
+	_, _, err := getSupplementFromMetadata(m).UpdateDeviceAssurance(ctx, d.Id(), buildDeviceAssuranceAndroid(d))
+	if err != nil {
+		return diag.Errorf("failed to update android device assurance policy: %v", err)
+	}
+	return resourcePolicyDeviceAssuranceAndroidRead(ctx, d, m)
+}
+
+func resourcePolicyDeviceAssuranceAndroidDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceAndroid)
+	}
+	if err := deleteDeviceAssurance(ctx, d, m); err != nil {
+		return diag.Errorf("failed to delete android device assurance policy: %v", err)
+	}
+	return nil
+}
+
+func buildDeviceAssuranceAndroid(d *schema.ResourceData) sdk.DeviceAssurance {
+	da := buildDeviceAssurance(d, sdk.DeviceAssurancePlatformAndroid)
+	da.DiskEncryptionType = buildDeviceAssuranceInclude(d, "disk_encryption_type")
+	da.Jailbreak = deviceAssuranceBool(d, "jailbreak")
+	da.ScreenLockType = buildDeviceAssuranceInclude(d, "screen_lock_type")
+	da.SecureHardwarePresent = deviceAssuranceBool(d, "secure_hardware_present")
+	return da
+}
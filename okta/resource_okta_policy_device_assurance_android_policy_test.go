@@ -0,0 +1,59 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaPolicyDeviceAssuranceAndroid_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(policyDeviceAssuranceAndroid)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", policyDeviceAssuranceAndroid)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(policyDeviceAssuranceAndroid, doesDeviceAssuranceExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesDeviceAssuranceExist),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "os_version", "12"),
+					resource.TestCheckResourceAttr(resourceName, "disk_encryption_type.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "jailbreak", "false"),
+					resource.TestCheckResourceAttr(resourceName, "screen_lock_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "secure_hardware_present", "true"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesDeviceAssuranceExist),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "os_version", "13"),
+					resource.TestCheckResourceAttr(resourceName, "disk_encryption_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "screen_lock_type.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func doesDeviceAssuranceExist(id string) (bool, error) {
+	_, response, err := getSupplementFromMetadata(testAccProvider.Meta()).GetDeviceAssurance(context.Background(), id)
+	return doesResourceExist(response, err)
+}
@@ -0,0 +1,106 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+var chromeOSDeviceTrustResource = buildDeviceAssuranceDTCResource(map[string]*schema.Schema{
+	"allow_screen_lock": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether the screen lock of the device is required to be allowed",
+	},
+	"key_trust_level": {
+		Type:             schema.TypeString,
+		Optional:         true,
+		Description:      "Trust level of the key used to sign the signals: CHROME_OS_VERIFIED_MODE or CHROME_OS_DEVELOPER_MODE",
+		ValidateDiagFunc: elemInSlice([]string{"CHROME_OS_VERIFIED_MODE", "CHROME_OS_DEVELOPER_MODE"}),
+	},
+})
+
+func resourcePolicyDeviceAssuranceChromeOS() *schema.Resource {
+	s := buildDeviceAssuranceSchema(map[string]*schema.Schema{
+		"chrome_device_trust": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Description: "Signals collected by the Chrome Device Trust integration, which is the only source of the ChromeOS signals",
+			Elem:        chromeOSDeviceTrustResource,
+		},
+	})
+	delete(s, "os_version")
+	return &schema.Resource{
+		CreateContext: resourcePolicyDeviceAssuranceChromeOSCreate,
+		ReadContext:   resourcePolicyDeviceAssuranceChromeOSRead,
+		UpdateContext: resourcePolicyDeviceAssuranceChromeOSUpdate,
+		DeleteContext: resourcePolicyDeviceAssuranceChromeOSDelete,
+		Importer:      createDeviceAssuranceImporter(sdk.DeviceAssurancePlatformChromeOS),
+		Description:   "Manages device assurance policy for ChromeOS devices",
+		Schema:        s,
+	}
+}
+
+func resourcePolicyDeviceAssuranceChromeOSCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceChromeOS)
+	}
+	da, _, err := getSupplementFromMetadata(m).CreateDeviceAssurance(ctx, buildDeviceAssuranceChromeOS(d))
+	if err != nil {
+		return diag.Errorf("failed to create ChromeOS device assurance policy: %v", err)
+	}
+	d.SetId(da.Id)
+	return resourcePolicyDeviceAssuranceChromeOSRead(ctx, d, m)
+}
+
+func resourcePolicyDeviceAssuranceChromeOSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceChromeOS)
+	}
+	da, err := getDeviceAssurance(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to get ChromeOS device assurance policy: %v", err)
+	}
+	if da == nil {
+		d.SetId("")
+		return nil
+	}
+	syncDeviceAssurance(d, da)
+	err = setNonPrimitives(d, map[string]interface{}{
+		"chrome_device_trust": flattenDeviceAssuranceDTC(da.ThirdPartySignalProviders, chromeOSDeviceTrustResource),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set ChromeOS device assurance policy properties: %v", err)
+	}
+	return nil
+}
+
+func resourcePolicyDeviceAssuranceChromeOSUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceChromeOS)
+	}
+	_, _, err := getSupplementFromMetadata(m).UpdateDeviceAssurance(ctx, d.Id(), buildDeviceAssuranceChromeOS(d))
+	if err != nil {
+		return diag.Errorf("failed to update ChromeOS device assurance policy: %v", err)
+	}
+	return resourcePolicyDeviceAssuranceChromeOSRead(ctx, d, m)
+}
+
+func resourcePolicyDeviceAssuranceChromeOSDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceChromeOS)
+	}
+	if err := deleteDeviceAssurance(ctx, d, m); err != nil {
+		return diag.Errorf("failed to delete ChromeOS device assurance policy: %v", err)
+	}
+	return nil
+}
+
+func buildDeviceAssuranceChromeOS(d *schema.ResourceData) sdk.DeviceAssurance {
+	da := buildDeviceAssurance(d, sdk.DeviceAssurancePlatformChromeOS)
+	da.ThirdPartySignalProviders = buildDeviceAssuranceDTC(d)
+	return da
+}
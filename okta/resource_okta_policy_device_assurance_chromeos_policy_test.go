@@ -0,0 +1,51 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaPolicyDeviceAssuranceChromeOS_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(policyDeviceAssuranceChromeOS)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", policyDeviceAssuranceChromeOS)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(policyDeviceAssuranceChromeOS, doesDeviceAssuranceExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesDeviceAssuranceExist),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "chrome_device_trust.0.key_trust_level", "CHROME_OS_VERIFIED_MODE"),
+					resource.TestCheckResourceAttr(resourceName, "chrome_device_trust.0.os_version", "105.0.5195.130"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesDeviceAssuranceExist),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "chrome_device_trust.0.os_version", "106.0.5249.103"),
+					resource.TestCheckResourceAttr(resourceName, "chrome_device_trust.0.device_enrollment_domain", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "chrome_device_trust.0.allow_screen_lock", "true"),
+					resource.TestCheckResourceAttr(resourceName, "chrome_device_trust.0.disk_encrypted", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,101 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourcePolicyDeviceAssuranceIOS() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyDeviceAssuranceIOSCreate,
+		ReadContext:   resourcePolicyDeviceAssuranceIOSRead,
+		UpdateContext: resourcePolicyDeviceAssuranceIOSUpdate,
+		DeleteContext: resourcePolicyDeviceAssuranceIOSDelete,
+		Importer:      createDeviceAssuranceImporter(sdk.DeviceAssurancePlatformIOS),
+		Description:   "Manages device assurance policy for iOS devices",
+		Schema: buildDeviceAssuranceSchema(map[string]*schema.Schema{
+			"jailbreak": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the jailbroken devices are allowed. Set to `false` to require devices that are not jailbroken",
+			},
+			"screen_lock_type": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of the screen lock types, that are considered secure: PASSCODE, BIOMETRIC",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: elemInSlice([]string{"PASSCODE", "BIOMETRIC"}),
+				},
+			},
+		}),
+	}
+}
+
+func resourcePolicyDeviceAssuranceIOSCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceIOS)
+	}
+	da, _, err := getSupplementFromMetadata(m).CreateDeviceAssurance(ctx, buildDeviceAssuranceIOS(d))
+	if err != nil {
+		return diag.Errorf("failed to create iOS device assurance policy: %v", err)
+	}
+	d.SetId(da.Id)
+	return resourcePolicyDeviceAssuranceIOSRead(ctx, d, m)
+}
+
+func resourcePolicyDeviceAssuranceIOSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceIOS)
+	}
+	da, err := getDeviceAssurance(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to get iOS device assurance policy: %v", err)
+	}
+	if da == nil {
+		d.SetId("")
+		return nil
+	}
+	syncDeviceAssurance(d, da)
+	if da.Jailbreak != nil {
+		_ = d.Set("jailbreak", *da.Jailbreak)
+	}
+	err = setNonPrimitives(d, map[string]interface{}{
+		"screen_lock_type": flattenDeviceAssuranceInclude(da.ScreenLockType),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set iOS device assurance policy properties: %v", err)
+	}
+	return nil
+}
+
+func resourcePolicyDeviceAssuranceIOSUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceIOS)
+	}
+	_, _, err := getSupplementFromMetadata(m).UpdateDeviceAssurance(ctx, d.Id(), buildDeviceAssuranceIOS(d))
+	if err != nil {
+		return diag.Errorf("failed to update iOS device assurance policy: %v", err)
+	}
+	return resourcePolicyDeviceAssuranceIOSRead(ctx, d, m)
+}
+
+func resourcePolicyDeviceAssuranceIOSDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceIOS)
+	}
+	if err := deleteDeviceAssurance(ctx, d, m); err != nil {
+		return diag.Errorf("failed to delete iOS device assurance policy: %v", err)
+	}
+	return nil
+}
+
+func buildDeviceAssuranceIOS(d *schema.ResourceData) sdk.DeviceAssurance {
+	da := buildDeviceAssurance(d, sdk.DeviceAssurancePlatformIOS)
+	da.Jailbreak = deviceAssuranceBool(d, "jailbreak")
+	da.ScreenLockType = buildDeviceAssuranceInclude(d, "screen_lock_type")
+	return da
+}
@@ -0,0 +1,126 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+var macOSDeviceTrustResource = buildDeviceAssuranceDTCResource(nil)
+
+func resourcePolicyDeviceAssuranceMacOS() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyDeviceAssuranceMacOSCreate,
+		ReadContext:   resourcePolicyDeviceAssuranceMacOSRead,
+		UpdateContext: resourcePolicyDeviceAssuranceMacOSUpdate,
+		DeleteContext: resourcePolicyDeviceAssuranceMacOSDelete,
+		Importer:      createDeviceAssuranceImporter(sdk.DeviceAssurancePlatformMacOS),
+		Description:   "Manages device assurance policy for macOS devices",
+		Schema: buildDeviceAssuranceSchema(map[string]*schema.Schema{
+			"disk_encryption_type": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of the disk encryption types, that are considered secure: ALL_INTERNAL_VOLUMES (FileVault)",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: elemInSlice([]string{"ALL_INTERNAL_VOLUMES"}),
+				},
+			},
+			"screen_lock_type": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of the screen lock types, that are considered secure: PASSCODE, BIOMETRIC",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: elemInSlice([]string{"PASSCODE", "BIOMETRIC"}),
+				},
+			},
+			"secure_hardware_present": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the device is required to have a Secure Enclave",
+			},
+			"device_posture_checks": deviceAssurancePostureChecksSchema,
+			"chrome_device_trust": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Third party signals collected by the Chrome Device Trust integration",
+				Elem:        macOSDeviceTrustResource,
+			},
+		}),
+	}
+}
+
+func resourcePolicyDeviceAssuranceMacOSCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceMacOS)
+	}
+	da, _, err := getSupplementFromMetadata(m).CreateDeviceAssurance(ctx, buildDeviceAssuranceMacOS(d))
+	if err != nil {
+		return diag.Errorf("failed to create macOS device assurance policy: %v", err)
+	}
+	d.SetId(da.Id)
+	return resourcePolicyDeviceAssuranceMacOSRead(ctx, d, m)
+}
+
+func resourcePolicyDeviceAssuranceMacOSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceMacOS)
+	}
+	da, err := getDeviceAssurance(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to get macOS device assurance policy: %v", err)
+	}
+	if da == nil {
+		d.SetId("")
+		return nil
+	}
+	syncDeviceAssurance(d, da)
+	if da.SecureHardwarePresent != nil {
+		_ = d.Set("secure_hardware_present", *da.SecureHardwarePresent)
+	}
+	err = setNonPrimitives(d, map[string]interface{}{
+		"disk_encryption_type":  flattenDeviceAssuranceInclude(da.DiskEncryptionType),
+		"screen_lock_type":      flattenDeviceAssuranceInclude(da.ScreenLockType),
+		"chrome_device_trust":   flattenDeviceAssuranceDTC(da.ThirdPartySignalProviders, macOSDeviceTrustResource),
+		"device_posture_checks": flattenDeviceAssurancePostureChecks(da.DevicePostureChecks),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set macOS device assurance policy properties: %v", err)
+	}
+	return nil
+}
+
+func resourcePolicyDeviceAssuranceMacOSUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceMacOS)
+	}
+	_, _, err := getSupplementFromMetadata(m).UpdateDeviceAssurance(ctx, d.Id(), buildDeviceAssuranceMacOS(d))
+	if err != nil {
+		return diag.Errorf("failed to update macOS device assurance policy: %v", err)
+	}
+	return resourcePolicyDeviceAssuranceMacOSRead(ctx, d, m)
+}
+
+func resourcePolicyDeviceAssuranceMacOSDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceMacOS)
+	}
+	if err := deleteDeviceAssurance(ctx, d, m); err != nil {
+		return diag.Errorf("failed to delete macOS device assurance policy: %v", err)
+	}
+	return nil
+}
+
+func buildDeviceAssuranceMacOS(d *schema.ResourceData) sdk.DeviceAssurance {
+	da := buildDeviceAssurance(d, sdk.DeviceAssurancePlatformMacOS)
+	da.DiskEncryptionType = buildDeviceAssuranceInclude(d, "disk_encryption_type")
+	da.ScreenLockType = buildDeviceAssuranceInclude(d, "screen_lock_type")
+	da.SecureHardwarePresent = deviceAssuranceBool(d, "secure_hardware_present")
+	da.ThirdPartySignalProviders = buildDeviceAssuranceDTC(d)
+	da.DevicePostureChecks = buildDeviceAssurancePostureChecks(d)
+	return da
+}
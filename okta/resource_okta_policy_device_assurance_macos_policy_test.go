@@ -0,0 +1,57 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaPolicyDeviceAssuranceMacOS_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(policyDeviceAssuranceMacOS)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", policyDeviceAssuranceMacOS)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(policyDeviceAssuranceMacOS, doesDeviceAssuranceExist),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesDeviceAssuranceExist),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "os_version", "12.4.1"),
+					resource.TestCheckResourceAttr(resourceName, "disk_encryption_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "screen_lock_type.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "secure_hardware_present", "true"),
+					resource.TestCheckResourceAttr(resourceName, "chrome_device_trust.#", "0"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureResourceExists(resourceName, doesDeviceAssuranceExist),
+					resource.TestCheckResourceAttr(resourceName, "name", buildResourceName(ri)),
+					resource.TestCheckResourceAttr(resourceName, "os_version", "13.0"),
+					resource.TestCheckResourceAttr(resourceName, "screen_lock_type.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "chrome_device_trust.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "chrome_device_trust.0.browser_version", "106.0.5249.103"),
+					resource.TestCheckResourceAttr(resourceName, "chrome_device_trust.0.disk_encrypted", "true"),
+					resource.TestCheckResourceAttr(resourceName, "chrome_device_trust.0.os_firewall", "true"),
+					resource.TestCheckResourceAttr(resourceName, "chrome_device_trust.0.key_trust_level", "CHROME_BROWSER_HW_KEY"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,157 @@
+package okta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+var windowsDeviceTrustResource = buildDeviceAssuranceDTCResource(map[string]*schema.Schema{
+	"crowd_strike_agent_id": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "ID of the CrowdStrike agent the device is required to run",
+	},
+	"crowd_strike_customer_id": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "ID of the CrowdStrike customer the device is required to belong to",
+	},
+	"secure_boot_enabled": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether the Secure Boot is required to be enabled",
+	},
+	"third_party_blocking_enabled": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Whether Chrome is required to block the third party software injection",
+	},
+	"windows_machine_domain": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Windows domain the device is required to be joined to",
+	},
+	"windows_user_domain": {
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Windows domain the user is required to belong to",
+	},
+})
+
+func resourcePolicyDeviceAssuranceWindows() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePolicyDeviceAssuranceWindowsCreate,
+		ReadContext:   resourcePolicyDeviceAssuranceWindowsRead,
+		UpdateContext: resourcePolicyDeviceAssuranceWindowsUpdate,
+		DeleteContext: resourcePolicyDeviceAssuranceWindowsDelete,
+		Importer:      createDeviceAssuranceImporter(sdk.DeviceAssurancePlatformWindows),
+		Description:   "Manages device assurance policy for Windows devices",
+		Schema: buildDeviceAssuranceSchema(map[string]*schema.Schema{
+			"disk_encryption_type": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of the disk encryption types, that are considered secure: ALL_INTERNAL_VOLUMES (BitLocker)",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: elemInSlice([]string{"ALL_INTERNAL_VOLUMES"}),
+				},
+			},
+			"screen_lock_type": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of the screen lock types, that are considered secure: PASSCODE, BIOMETRIC (Windows Hello)",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: elemInSlice([]string{"PASSCODE", "BIOMETRIC"}),
+				},
+			},
+			"secure_hardware_present": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether the device is required to have a Trusted Platform Module (TPM)",
+			},
+			"device_posture_checks": deviceAssurancePostureChecksSchema,
+			"chrome_device_trust": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Third party signals collected by the Chrome Device Trust integration",
+				Elem:        windowsDeviceTrustResource,
+			},
+		}),
+	}
+}
+
+func resourcePolicyDeviceAssuranceWindowsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceWindows)
+	}
+	da, _, err := getSupplementFromMetadata(m).CreateDeviceAssurance(ctx, buildDeviceAssuranceWindows(d))
+	if err != nil {
+		return diag.Errorf("failed to create Windows device assurance policy: %v", err)
+	}
+	d.SetId(da.Id)
+	return resourcePolicyDeviceAssuranceWindowsRead(ctx, d, m)
+}
+
+func resourcePolicyDeviceAssuranceWindowsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceWindows)
+	}
+	da, err := getDeviceAssurance(ctx, d, m)
+	if err != nil {
+		return diag.Errorf("failed to get Windows device assurance policy: %v", err)
+	}
+	if da == nil {
+		d.SetId("")
+		return nil
+	}
+	syncDeviceAssurance(d, da)
+	if da.SecureHardwarePresent != nil {
+		_ = d.Set("secure_hardware_present", *da.SecureHardwarePresent)
+	}
+	err = setNonPrimitives(d, map[string]interface{}{
+		"disk_encryption_type":  flattenDeviceAssuranceInclude(da.DiskEncryptionType),
+		"screen_lock_type":      flattenDeviceAssuranceInclude(da.ScreenLockType),
+		"chrome_device_trust":   flattenDeviceAssuranceDTC(da.ThirdPartySignalProviders, windowsDeviceTrustResource),
+		"device_posture_checks": flattenDeviceAssurancePostureChecks(da.DevicePostureChecks),
+	})
+	if err != nil {
+		return diag.Errorf("failed to set Windows device assurance policy properties: %v", err)
+	}
+	return nil
+}
+
+func resourcePolicyDeviceAssuranceWindowsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceWindows)
+	}
+	_, _, err := getSupplementFromMetadata(m).UpdateDeviceAssurance(ctx, d.Id(), buildDeviceAssuranceWindows(d))
+	if err != nil {
+		return diag.Errorf("failed to update Windows device assurance policy: %v", err)
+	}
+	return resourcePolicyDeviceAssuranceWindowsRead(ctx, d, m)
+}
+
+func resourcePolicyDeviceAssuranceWindowsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if isClassicOrg(m) {
+		return resourceOIEOnlyFeatureError(policyDeviceAssuranceWindows)
+	}
+	if err := deleteDeviceAssurance(ctx, d, m); err != nil {
+		return diag.Errorf("failed to delete Windows device assurance policy: %v", err)
+	}
+	return nil
+}
+
+func buildDeviceAssuranceWindows(d *schema.ResourceData) sdk.DeviceAssurance {
+	da := buildDeviceAssurance(d, sdk.DeviceAssurancePlatformWindows)
+	da.DiskEncryptionType = buildDeviceAssuranceInclude(d, "disk_encryption_type")
+	da.ScreenLockType = buildDeviceAssuranceInclude(d, "screen_lock_type")
+	da.SecureHardwarePresent = deviceAssuranceBool(d, "secure_hardware_present")
+	da.ThirdPartySignalProviders = buildDeviceAssuranceDTC(d)
+	da.DevicePostureChecks = buildDeviceAssurancePostureChecks(d)
+	return da
+}
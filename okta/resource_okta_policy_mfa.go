@@ -18,15 +18,13 @@ func resourcePolicyMfa() *schema.Resource {
 		ReadContext:   resourcePolicyMfaRead,
 		UpdateContext: resourcePolicyMfaUpdate,
 		DeleteContext: resourcePolicyMfaDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema: buildMfaPolicySchema(buildFactorSchemaProviders()),
+		Importer:      createPolicyImporter(sdk.MfaPolicyType),
+		Schema:        buildMfaPolicySchema(buildFactorSchemaProviders()),
 	}
 }
 
 func resourcePolicyMfaCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	policy := buildMFAPolicy(d)
+	policy := buildMFAPolicy(d, m)
 	err := createPolicy(ctx, d, m, policy)
 	if err != nil {
 		return diag.Errorf("failed to create MFA policy: %v", err)
@@ -53,7 +51,7 @@ func resourcePolicyMfaRead(ctx context.Context, d *schema.ResourceData, m interf
 }
 
 func resourcePolicyMfaUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	policy := buildMFAPolicy(d)
+	policy := buildMFAPolicy(d, m)
 	err := updatePolicy(ctx, d, m, policy)
 	if err != nil {
 		return diag.Errorf("failed to update MFA policy: %v", err)
@@ -70,7 +68,7 @@ func resourcePolicyMfaDelete(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 // create or update a MFA policy
-func buildMFAPolicy(d *schema.ResourceData) sdk.Policy {
+func buildMFAPolicy(d *schema.ResourceData, m interface{}) sdk.Policy {
 	policy := sdk.MfaPolicy()
 	policy.Name = d.Get("name").(string)
 	policy.Status = d.Get("status").(string)
@@ -78,16 +76,18 @@ func buildMFAPolicy(d *schema.ResourceData) sdk.Policy {
 	if priority, ok := d.GetOk("priority"); ok {
 		policy.Priority = int64(priority.(int))
 	}
-	policy.Settings = buildSettings(d)
+	policy.Settings = buildSettings(d, m)
 	policy.Conditions = &okta.PolicyRuleConditions{
 		People: getGroups(d),
 	}
 	return policy
 }
 
-// Opposite of syncSettings(): Build the corresponding sdk.PolicySettings based on the schema.ResourceData
-func buildSettings(d *schema.ResourceData) *sdk.PolicySettings {
-	if d.Get("is_oie") == true {
+// Opposite of syncSettings(): Build the corresponding sdk.PolicySettings based on the schema.ResourceData.
+// Either the authenticator or the factor settings are used, the others are ignored so the same configuration
+// can be applied to both Classic and OIE orgs.
+func buildSettings(d *schema.ResourceData, m interface{}) *sdk.PolicySettings {
+	if isMfaPolicyOIE(d, m) {
 		authenticators := []*sdk.PolicyAuthenticator{}
 
 		for _, key := range remove(sdk.AuthenticatorProviders, sdk.OktaPasswordFactor) {
@@ -133,6 +133,19 @@ func buildSettings(d *schema.ResourceData) *sdk.PolicySettings {
 	}
 }
 
+// isMfaPolicyOIE whether the policy uses authenticators, unless it's configured explicitly it depends on the
+// engine of the org for the new policies, and on the settings type read back from the API for the existing ones,
+// so the existing policies using factors are not rewritten with authenticators
+func isMfaPolicyOIE(d *schema.ResourceData, m interface{}) bool {
+	if v := d.GetRawConfig().GetAttr("is_oie"); !v.IsNull() {
+		return v.True()
+	}
+	if d.IsNewResource() {
+		return !isClassicOrg(m)
+	}
+	return d.Get("is_oie").(bool)
+}
+
 func buildFactorProvider(d *schema.ResourceData, key string) *sdk.PolicyFactor {
 	rawFactor := d.Get(key).(map[string]interface{})
 	consent := rawFactor["consent_type"]
@@ -152,15 +165,18 @@ func buildFactorProvider(d *schema.ResourceData, key string) *sdk.PolicyFactor {
 
 // Syncs either classic factors or OIE authenticators into the resource data.
 func syncSettings(d *schema.ResourceData, settings *sdk.PolicySettings) {
+	if settings == nil {
+		return
+	}
 	_ = d.Set("is_oie", settings.Type == "AUTHENTICATORS")
 
 	if settings.Type == "AUTHENTICATORS" {
 		for _, key := range remove(sdk.AuthenticatorProviders, sdk.OktaPasswordFactor) {
 			syncAuthenticator(d, key, settings.Authenticators)
 		}
-	} else {
+	} else if settings.Factors != nil {
 		syncFactor(d, sdk.DuoFactor, settings.Factors.Duo)
-		syncFactor(d, sdk.HotpFactor, settings.Factors.YubikeyToken)
+		syncFactor(d, sdk.HotpFactor, settings.Factors.Hotp)
 		syncFactor(d, sdk.FidoU2fFactor, settings.Factors.FidoU2f)
 		syncFactor(d, sdk.FidoWebauthnFactor, settings.Factors.FidoWebauthn)
 		syncFactor(d, sdk.GoogleOtpFactor, settings.Factors.GoogleOtp)
@@ -178,12 +194,17 @@ func syncSettings(d *schema.ResourceData, settings *sdk.PolicySettings) {
 }
 
 func syncFactor(d *schema.ResourceData, k string, f *sdk.PolicyFactor) {
-	if f != nil {
-		_ = d.Set(k, map[string]interface{}{
-			"consent_type": f.Consent.Type,
-			"enroll":       f.Enroll.Self,
-		})
+	if f == nil {
+		return
+	}
+	factor := map[string]interface{}{}
+	if f.Consent != nil {
+		factor["consent_type"] = f.Consent.Type
+	}
+	if f.Enroll != nil {
+		factor["enroll"] = f.Enroll.Self
 	}
+	_ = d.Set(k, factor)
 }
 
 func syncAuthenticator(d *schema.ResourceData, k string, authenticators []*sdk.PolicyAuthenticator) {
@@ -192,7 +213,7 @@ func syncAuthenticator(d *schema.ResourceData, k string, authenticators []*sdk.P
 			// Skip OktaPassword as this should never be returned for MFA policies using authenticator.
 			// Enrollment policy changes for OIE for password
 			// https://help.okta.com/okta_help.htm?type=oie&id=ext-about-mfa-enrol-policies
-			if k != sdk.OktaPasswordFactor {
+			if k != sdk.OktaPasswordFactor && authenticator.Enroll != nil {
 				_ = d.Set(k, map[string]interface{}{
 					"enroll": authenticator.Enroll.Self,
 				})
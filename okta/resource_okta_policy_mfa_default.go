@@ -38,7 +38,7 @@ func resourcePolicyMfaDefaultCreateOrUpdate(ctx context.Context, d *schema.Resou
 		}
 		id = policy.Id
 	}
-	_, _, err := getSupplementFromMetadata(m).UpdatePolicy(ctx, id, buildDefaultMFAPolicy(d))
+	_, _, err := getSupplementFromMetadata(m).UpdatePolicy(ctx, id, buildDefaultMFAPolicy(d, m))
 	if err != nil {
 		return diag.Errorf("failed to update default MFA policy: %v", err)
 	}
@@ -59,13 +59,13 @@ func resourcePolicyMfaDefaultRead(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
-func buildDefaultMFAPolicy(d *schema.ResourceData) sdk.Policy {
+func buildDefaultMFAPolicy(d *schema.ResourceData, m interface{}) sdk.Policy {
 	policy := sdk.MfaPolicy()
 	policy.Name = d.Get("name").(string)
 	policy.Status = d.Get("status").(string)
 	policy.Description = d.Get("description").(string)
 	policy.Priority = int64(d.Get("priority").(int))
-	policy.Settings = buildSettings(d)
+	policy.Settings = buildSettings(d, m)
 	policy.Conditions = &okta.PolicyRuleConditions{
 		People: &okta.PolicyPeopleCondition{
 			Groups: &okta.GroupCondition{
@@ -2,6 +2,7 @@ package okta
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -26,6 +27,12 @@ func resourcePolicyPassword() *schema.Resource {
 				Description:      "Authentication Provider: OKTA, ACTIVE_DIRECTORY or LDAP",
 				Default:          "OKTA",
 			},
+			"auth_provider_include": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IDs of the ACTIVE_DIRECTORY or LDAP directory integrations the policy applies to",
+			},
 			"password_min_length": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -181,6 +188,9 @@ func resourcePolicyPassword() *schema.Resource {
 }
 
 func resourcePolicyPasswordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validatePasswordPolicy(d); err != nil {
+		return diag.FromErr(err)
+	}
 	template := buildPasswordPolicy(d)
 	err := createPolicy(ctx, d, m, template)
 	if err != nil {
@@ -200,8 +210,12 @@ func resourcePolicyPasswordRead(ctx context.Context, d *schema.ResourceData, m i
 
 	// Update with upstream state when it is manually updated from Okta UI or API directly.
 	// See https://github.com/okta/terraform-provider-okta/issues/61
-	if policy.Conditions.AuthProvider != nil && policy.Conditions.AuthProvider.Provider != "" {
+	if policy.Conditions != nil && policy.Conditions.AuthProvider != nil && policy.Conditions.AuthProvider.Provider != "" {
 		_ = d.Set("auth_provider", policy.Conditions.AuthProvider.Provider)
+		err = d.Set("auth_provider_include", convertStringSliceToSetNullable(policy.Conditions.AuthProvider.Include))
+		if err != nil {
+			return diag.Errorf("failed to set password policy auth provider includes: %v", err)
+		}
 	}
 
 	if policy.Settings != nil {
@@ -225,13 +239,10 @@ func resourcePolicyPasswordRead(ctx context.Context, d *schema.ResourceData, m i
 		_ = d.Set("password_max_lockout_attempts", policy.Settings.Password.Lockout.MaxAttempts)
 		_ = d.Set("password_auto_unlock_minutes", policy.Settings.Password.Lockout.AutoUnlockMinutes)
 		_ = d.Set("password_show_lockout_failures", policy.Settings.Password.Lockout.ShowLockoutFailures)
-		_ = d.Set("question_min_length", policy.Settings.Recovery.Factors.RecoveryQuestion.Properties.Complexity.MinLength)
-		_ = d.Set("recovery_email_token", policy.Settings.Recovery.Factors.OktaEmail.Properties.RecoveryToken.TokenLifetimeMinutes)
-		_ = d.Set("sms_recovery", policy.Settings.Recovery.Factors.OktaSms.Status)
-		_ = d.Set("email_recovery", policy.Settings.Recovery.Factors.OktaEmail.Status)
-		_ = d.Set("question_recovery", policy.Settings.Recovery.Factors.RecoveryQuestion.Status)
-		_ = d.Set("call_recovery", policy.Settings.Recovery.Factors.OktaCall.Status)
-		_ = d.Set("skip_unlock", policy.Settings.Delegation.Options.SkipUnlock)
+		syncPasswordPolicyRecovery(d, policy.Settings.Recovery)
+		if policy.Settings.Delegation != nil && policy.Settings.Delegation.Options != nil {
+			_ = d.Set("skip_unlock", policy.Settings.Delegation.Options.SkipUnlock)
+		}
 
 		excludedAttrs := policy.Settings.Password.Complexity.ExcludeAttributes
 		if len(excludedAttrs) > 0 {
@@ -253,6 +264,9 @@ func resourcePolicyPasswordRead(ctx context.Context, d *schema.ResourceData, m i
 }
 
 func resourcePolicyPasswordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if err := validatePasswordPolicy(d); err != nil {
+		return diag.FromErr(err)
+	}
 	template := buildPasswordPolicy(d)
 	err := updatePolicy(ctx, d, m, template)
 	if err != nil {
@@ -283,6 +297,7 @@ func buildPasswordPolicy(d *schema.ResourceData) sdk.Policy {
 	template.Conditions = &okta.PolicyRuleConditions{
 		AuthProvider: &okta.PasswordPolicyAuthenticationProviderCondition{
 			Provider: d.Get("auth_provider").(string),
+			Include:  convertInterfaceToStringSetNullable(d.Get("auth_provider_include")),
 		},
 		People: getGroups(d),
 	}
@@ -352,6 +367,40 @@ func buildPasswordPolicy(d *schema.ResourceData) sdk.Policy {
 	return template
 }
 
+// syncPasswordPolicyRecovery the recovery factors which are not returned are left untouched
+func syncPasswordPolicyRecovery(d *schema.ResourceData, recovery *okta.PasswordPolicyRecoverySettings) {
+	if recovery == nil || recovery.Factors == nil {
+		return
+	}
+	factors := recovery.Factors
+	if factors.RecoveryQuestion != nil {
+		_ = d.Set("question_recovery", factors.RecoveryQuestion.Status)
+		if factors.RecoveryQuestion.Properties != nil && factors.RecoveryQuestion.Properties.Complexity != nil {
+			_ = d.Set("question_min_length", factors.RecoveryQuestion.Properties.Complexity.MinLength)
+		}
+	}
+	if factors.OktaEmail != nil {
+		_ = d.Set("email_recovery", factors.OktaEmail.Status)
+		if factors.OktaEmail.Properties != nil && factors.OktaEmail.Properties.RecoveryToken != nil {
+			_ = d.Set("recovery_email_token", factors.OktaEmail.Properties.RecoveryToken.TokenLifetimeMinutes)
+		}
+	}
+	if factors.OktaSms != nil {
+		_ = d.Set("sms_recovery", factors.OktaSms.Status)
+	}
+	if factors.OktaCall != nil {
+		_ = d.Set("call_recovery", factors.OktaCall.Status)
+	}
+}
+
+// validatePasswordPolicy directory integrations can only be set for the delegated authentication providers
+func validatePasswordPolicy(d *schema.ResourceData) error {
+	if d.Get("auth_provider").(string) == "OKTA" && d.Get("auth_provider_include").(*schema.Set).Len() > 0 {
+		return errors.New("'auth_provider_include' can only be set when 'auth_provider' is 'ACTIVE_DIRECTORY' or 'LDAP'")
+	}
+	return nil
+}
+
 func getExcludedAttrs(excludeFirstName, excludeLastName bool) []string {
 	var excludedAttrs []string
 	if excludeFirstName {
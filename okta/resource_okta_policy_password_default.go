@@ -232,13 +232,10 @@ func resourcePolicyPasswordDefaultRead(ctx context.Context, d *schema.ResourceDa
 	_ = d.Set("password_max_lockout_attempts", policy.Settings.Password.Lockout.MaxAttempts)
 	_ = d.Set("password_auto_unlock_minutes", policy.Settings.Password.Lockout.AutoUnlockMinutes)
 	_ = d.Set("password_show_lockout_failures", policy.Settings.Password.Lockout.ShowLockoutFailures)
-	_ = d.Set("question_min_length", policy.Settings.Recovery.Factors.RecoveryQuestion.Properties.Complexity.MinLength)
-	_ = d.Set("recovery_email_token", policy.Settings.Recovery.Factors.OktaEmail.Properties.RecoveryToken.TokenLifetimeMinutes)
-	_ = d.Set("sms_recovery", policy.Settings.Recovery.Factors.OktaSms.Status)
-	_ = d.Set("email_recovery", policy.Settings.Recovery.Factors.OktaEmail.Status)
-	_ = d.Set("question_recovery", policy.Settings.Recovery.Factors.RecoveryQuestion.Status)
-	_ = d.Set("call_recovery", policy.Settings.Recovery.Factors.OktaCall.Status)
-	_ = d.Set("skip_unlock", policy.Settings.Delegation.Options.SkipUnlock)
+	syncPasswordPolicyRecovery(d, policy.Settings.Recovery)
+	if policy.Settings.Delegation != nil && policy.Settings.Delegation.Options != nil {
+		_ = d.Set("skip_unlock", policy.Settings.Delegation.Options.SkipUnlock)
+	}
 	for _, v := range policy.Settings.Password.Complexity.ExcludeAttributes {
 		switch v {
 		case "firstName":
@@ -8,9 +8,38 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
+func TestValidatePasswordPolicy(t *testing.T) {
+	tests := []struct {
+		provider string
+		include  []interface{}
+		valid    bool
+	}{
+		{"OKTA", nil, true},
+		{"OKTA", []interface{}{"0oa1"}, false},
+		{"ACTIVE_DIRECTORY", nil, true},
+		{"ACTIVE_DIRECTORY", []interface{}{"0oa1", "0oa2"}, true},
+		{"LDAP", []interface{}{"0oa1"}, true},
+	}
+	for _, test := range tests {
+		d := schema.TestResourceDataRaw(t, resourcePolicyPassword().Schema, map[string]interface{}{
+			"name":                  "test",
+			"auth_provider":         test.provider,
+			"auth_provider_include": test.include,
+		})
+		err := validatePasswordPolicy(d)
+		if test.valid && err != nil {
+			t.Errorf("expected %v directories to be valid for the '%s' auth provider: %v", test.include, test.provider, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("expected %v directories not to be valid for the '%s' auth provider", test.include, test.provider)
+		}
+	}
+}
+
 func TestAccOktaPolicyPassword_crud(t *testing.T) {
 	ri := acctest.RandInt()
 	mgr := newFixtureManager(policyPassword)
@@ -60,6 +89,7 @@ func TestAccOktaPolicyPassword_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "recovery_email_token", "20160"),
 					resource.TestCheckResourceAttr(resourceName, "sms_recovery", statusActive),
 					resource.TestCheckResourceAttr(resourceName, "call_recovery", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "question_min_length", "10"),
 				),
 			},
 		},
@@ -74,6 +74,8 @@ func resourcePolicyRuleIdpDiscoveryCreate(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("either 'policyid' or 'policy_id' field should be set")
 	}
 	logger(m).Info("creating IdP discovery policy rule", "policy_id", policyID)
+	oktaMutexKV.Lock(policyID)
+	defer oktaMutexKV.Unlock(policyID)
 	newRule := buildIdpDiscoveryRule(d)
 	rule, _, err := getSupplementFromMetadata(m).CreateIdpDiscoveryRule(ctx, policyID, *newRule, nil)
 	if err != nil {
@@ -142,6 +144,8 @@ func resourcePolicyRuleIdpDiscoveryUpdate(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("either 'policyid' or 'policy_id' field should be set")
 	}
 	logger(m).Info("updating IdP discovery policy rule", "id", d.Id(), "policy_id", policyID)
+	oktaMutexKV.Lock(policyID)
+	defer oktaMutexKV.Unlock(policyID)
 	newRule := buildIdpDiscoveryRule(d)
 	rule, _, err := getSupplementFromMetadata(m).UpdateIdpDiscoveryRule(ctx, policyID, d.Id(), *newRule, nil)
 	if err != nil {
@@ -163,6 +167,8 @@ func resourcePolicyRuleIdpDiscoveryDelete(ctx context.Context, d *schema.Resourc
 		return diag.Errorf("either 'policyid' or 'policy_id' field should be set")
 	}
 	logger(m).Info("deleting IdP discovery policy rule", "id", d.Id(), "policy_id", policyID)
+	oktaMutexKV.Lock(policyID)
+	defer oktaMutexKV.Unlock(policyID)
 	_, err := getOktaClientFromMetadata(m).Policy.DeletePolicyRule(ctx, policyID, d.Id())
 	if err != nil {
 		return diag.Errorf("failed to delete IDP discovery policy rule: %v", err)
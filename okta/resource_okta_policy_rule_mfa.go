@@ -15,6 +15,7 @@ func resourcePolicyMfaRule() *schema.Resource {
 		ReadContext:   resourcePolicyMfaRuleRead,
 		UpdateContext: resourcePolicyMfaRuleUpdate,
 		DeleteContext: resourcePolicyMfaRuleDelete,
+		CustomizeDiff: validateDefaultRuleDiff,
 		Importer:      createPolicyRuleImporter(),
 		Schema: buildRuleSchema(map[string]*schema.Schema{
 			"enroll": {
@@ -85,7 +86,9 @@ func resourcePolicyMfaRuleUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourcePolicyMfaRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	err := deleteRule(ctx, d, m, false)
+	err := deleteRule(ctx, d, m, false, func() (sdk.PolicyRule, error) {
+		return defaultRuleTemplate(resourcePolicyMfaRule(), d, buildMfaPolicyRule)
+	})
 	if err != nil {
 		return diag.Errorf("failed to delete MFA policy rule: %v", err)
 	}
@@ -15,6 +15,7 @@ func resourcePolicyPasswordRule() *schema.Resource {
 		ReadContext:   resourcePolicyPasswordRuleRead,
 		UpdateContext: resourcePolicyPasswordRuleUpdate,
 		DeleteContext: resourcePolicyPasswordRuleDelete,
+		CustomizeDiff: validateDefaultRuleDiff,
 		Importer:      createPolicyRuleImporter(),
 		Schema: buildRuleSchema(map[string]*schema.Schema{
 			"password_change": {
@@ -80,7 +81,9 @@ func resourcePolicyPasswordRuleUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourcePolicyPasswordRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	err := deleteRule(ctx, d, m, false)
+	err := deleteRule(ctx, d, m, false, func() (sdk.PolicyRule, error) {
+		return defaultRuleTemplate(resourcePolicyPasswordRule(), d, buildPolicyRulePassword)
+	})
 	if err != nil {
 		return diag.Errorf("failed to delete password policy rule: %v", err)
 	}
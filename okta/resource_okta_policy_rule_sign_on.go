@@ -17,6 +17,7 @@ func resourcePolicySignOnRule() *schema.Resource {
 		ReadContext:   resourcePolicySignOnRuleRead,
 		UpdateContext: resourcePolicySignOnRuleUpdate,
 		DeleteContext: resourcePolicySignOnRuleDelete,
+		CustomizeDiff: validateDefaultRuleDiff,
 		Importer:      createPolicyRuleImporter(),
 		Schema: buildRuleSchema(map[string]*schema.Schema{
 			"authtype": {
@@ -57,16 +58,18 @@ func resourcePolicySignOnRule() *schema.Resource {
 				Description: "Elapsed time before the next MFA challenge",
 			},
 			"session_idle": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Max minutes a session can be idle.",
-				Default:     120,
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Description:      "Max minutes a session can be idle.",
+				Default:          120,
+				ValidateDiagFunc: intAtLeast(1),
 			},
 			"session_lifetime": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Max minutes a session is active: Disable = 0.",
-				Default:     120,
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Description:      "Max minutes a session is active: Disable = 0.",
+				Default:          120,
+				ValidateDiagFunc: intAtLeast(0),
 			},
 			"session_persistent": {
 				Type:        schema.TypeBool,
@@ -77,9 +80,19 @@ func resourcePolicySignOnRule() *schema.Resource {
 			"risc_level": {
 				Type:             schema.TypeString,
 				Optional:         true,
+				Computed:         true,
 				ValidateDiagFunc: elemInSlice([]string{"", "ANY", "LOW", "MEDIUM", "HIGH"}),
 				Description:      "Risc level: ANY, LOW, MEDIUM or HIGH",
-				Default:          "ANY",
+				Deprecated:       "The `risc_level` field is deprecated, please use `risk_score` instead.",
+				ConflictsWith:    []string{"risk_score"},
+			},
+			"risk_score": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: elemInSlice([]string{"", "ANY", "LOW", "MEDIUM", "HIGH"}),
+				Description:      "Risk score: ANY, LOW, MEDIUM or HIGH. Default is ANY for the new rules, the existing rules keep their risk score when it is not configured",
+				ConflictsWith:    []string{"risc_level"},
 			},
 			"behaviors": {
 				Type:        schema.TypeSet,
@@ -95,8 +108,9 @@ func resourcePolicySignOnRule() *schema.Resource {
 				ValidateDiagFunc: elemInSlice([]string{"PASSWORD_IDP", "PASSWORD_IDP_ANY_FACTOR"}),
 			},
 			"factor_sequence": {
-				Type:     schema.TypeList,
-				Optional: true,
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Auth factor sequences, the user has to complete one of them. Should be set if access is CHALLENGE",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"primary_criteria_provider": {
@@ -110,8 +124,9 @@ func resourcePolicySignOnRule() *schema.Resource {
 							Description: "Type of a Factor",
 						},
 						"secondary_criteria": {
-							Type:     schema.TypeList,
-							Optional: true,
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Factors of which one has to be completed after the primary factor",
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"provider": {
@@ -168,15 +183,22 @@ func resourcePolicySignOnRuleRead(ctx context.Context, d *schema.ResourceData, m
 	if rule == nil {
 		return nil
 	}
+	if rule.Actions.SignOn == nil {
+		return diag.Errorf("sign-on policy rule '%s' has no sign-on actions", d.Id())
+	}
 	// Update with upstream state to prevent stale state
-	_ = d.Set("authtype", rule.Conditions.AuthContext.AuthType)
+	if rule.Conditions != nil && rule.Conditions.AuthContext != nil {
+		_ = d.Set("authtype", rule.Conditions.AuthContext.AuthType)
+	}
 	_ = d.Set("access", rule.Actions.SignOn.Access)
 	_ = d.Set("mfa_required", rule.Actions.SignOn.RequireFactor)
 	_ = d.Set("mfa_remember_device", rule.Actions.SignOn.RememberDeviceByDefault)
 	_ = d.Set("mfa_lifetime", rule.Actions.SignOn.FactorLifetime)
-	_ = d.Set("session_idle", rule.Actions.SignOn.Session.MaxSessionIdleMinutes)
-	_ = d.Set("session_lifetime", rule.Actions.SignOn.Session.MaxSessionLifetimeMinutes)
-	_ = d.Set("session_persistent", rule.Actions.SignOn.Session.UsePersistentCookie)
+	if rule.Actions.SignOn.Session != nil {
+		_ = d.Set("session_idle", rule.Actions.SignOn.Session.MaxSessionIdleMinutes)
+		_ = d.Set("session_lifetime", rule.Actions.SignOn.Session.MaxSessionLifetimeMinutes)
+		_ = d.Set("session_persistent", rule.Actions.SignOn.Session.UsePersistentCookie)
+	}
 	if rule.Actions.SignOn.FactorPromptMode != "" {
 		_ = d.Set("mfa_prompt", rule.Actions.SignOn.FactorPromptMode)
 	}
@@ -186,6 +208,7 @@ func resourcePolicySignOnRuleRead(ctx context.Context, d *schema.ResourceData, m
 	if rule.Conditions != nil {
 		if rule.Conditions.RiskScore != nil {
 			_ = d.Set("risc_level", rule.Conditions.RiskScore.Level)
+			_ = d.Set("risk_score", rule.Conditions.RiskScore.Level)
 		}
 		if rule.Conditions.Risk != nil {
 			err = setNonPrimitives(d, map[string]interface{}{
@@ -196,36 +219,16 @@ func resourcePolicySignOnRuleRead(ctx context.Context, d *schema.ResourceData, m
 			}
 		}
 	}
-	if rule.Conditions.IdentityProvider != nil {
+	if rule.Conditions != nil && rule.Conditions.IdentityProvider != nil {
 		_ = d.Set("identity_provider", rule.Conditions.IdentityProvider.Provider)
 		if rule.Conditions.IdentityProvider.Provider == "SPECIFIC_IDP" {
 			_ = d.Set("identity_provider_ids", convertStringSliceToInterfaceSlice(rule.Conditions.IdentityProvider.IdpIds))
 		}
 	}
 
-	if rule.Actions.SignOn.Access == "CHALLENGE" {
-		chain := rule.Actions.SignOn.Challenge.Chain
-		arr := make([]map[string]interface{}, len(chain))
-		for i, c := range chain {
-			arr[i] = map[string]interface{}{
-				"primary_criteria_provider":    c.Criteria[0].Provider,
-				"primary_criteria_factor_type": c.Criteria[0].FactorType,
-			}
-			if len(c.Next) > 0 {
-				scs := make([]map[string]interface{}, len(c.Next[0].Criteria))
-				for j, sc := range c.Next[0].Criteria {
-					scs[j] = map[string]interface{}{
-						"provider":    sc.Provider,
-						"factor_type": sc.FactorType,
-					}
-				}
-				arr[i]["secondary_criteria"] = scs
-			}
-		}
-		err = setNonPrimitives(d, map[string]interface{}{"factor_sequence": arr})
-		if err != nil {
-			return diag.Errorf("failed to set OAuth application properties: %v", err)
-		}
+	err = setNonPrimitives(d, map[string]interface{}{"factor_sequence": flattenSignOnFactorSequence(rule.Actions.SignOn)})
+	if err != nil {
+		return diag.Errorf("failed to set sign-on policy rule factor sequence: %v", err)
 	}
 	err = syncRuleFromUpstream(d, rule)
 	if err != nil {
@@ -248,9 +251,11 @@ func resourcePolicySignOnRuleUpdate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourcePolicySignOnRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	err := deleteRule(ctx, d, m, true)
+	err := deleteRule(ctx, d, m, true, func() (sdk.PolicyRule, error) {
+		return defaultRuleTemplate(resourcePolicySignOnRule(), d, buildSignOnPolicyRule)
+	})
 	if err != nil {
-		return diag.Errorf("failed to delete MFA policy rule: %v", err)
+		return diag.Errorf("failed to delete sign-on policy rule: %v", err)
 	}
 	return nil
 }
@@ -285,10 +290,9 @@ func buildSignOnPolicyRule(d *schema.ResourceData) sdk.PolicyRule {
 			Behaviors: convertInterfaceToStringSetNullable(bi),
 		}
 	}
-	ri, ok := d.GetOk("risc_level")
-	if ok {
+	if level := signOnPolicyRuleRiskScore(d); level != "" {
 		template.Conditions.RiskScore = &okta.RiskScorePolicyRuleCondition{
-			Level: ri.(string),
+			Level: level,
 		}
 	}
 	template.Actions = sdk.PolicyRuleActions{
@@ -340,25 +344,85 @@ func buildSignOnPolicyRule(d *schema.ResourceData) sdk.PolicyRule {
 	return template
 }
 
+// flattenSignOnFactorSequence chains without the primary factor are skipped, and the sequences removed
+// outside of Terraform are shown as drift
+func flattenSignOnFactorSequence(signOn *sdk.SignOnPolicyRuleSignOnActions) []interface{} {
+	if signOn.Access != "CHALLENGE" || signOn.Challenge == nil {
+		return nil
+	}
+	var arr []interface{}
+	for _, c := range signOn.Challenge.Chain {
+		if len(c.Criteria) == 0 {
+			continue
+		}
+		seq := map[string]interface{}{
+			"primary_criteria_provider":    c.Criteria[0].Provider,
+			"primary_criteria_factor_type": c.Criteria[0].FactorType,
+		}
+		if len(c.Next) > 0 {
+			scs := make([]interface{}, len(c.Next[0].Criteria))
+			for j, sc := range c.Next[0].Criteria {
+				scs[j] = map[string]interface{}{
+					"provider":    sc.Provider,
+					"factor_type": sc.FactorType,
+				}
+			}
+			seq["secondary_criteria"] = scs
+		}
+		arr = append(arr, seq)
+	}
+	return arr
+}
+
 func validateSignOnPolicyRule(d *schema.ResourceData) error {
 	_, ok := d.GetOk("factor_sequence")
 	isChallenge := d.Get("access").(string) == "CHALLENGE"
 	if (!ok && isChallenge) || (ok && !isChallenge) {
 		return errors.New("'factor_sequence' can only be set when access is 'CHALLENGE' and vice versa")
 	}
+	lifetime := d.Get("session_lifetime").(int)
+	if lifetime > 0 && d.Get("session_idle").(int) > lifetime {
+		return errors.New("'session_idle' can't be greater than 'session_lifetime' unless the session lifetime is disabled")
+	}
+	ip, ok := d.GetOk("identity_provider")
+	if ok && ip == "SPECIFIC_IDP" && len(convertInterfaceToStringArrNullable(d.Get("identity_provider_ids"))) < 1 {
+		return errors.New("'identity_provider_ids' should have at least one element when 'identity_provider' is 'SPECIFIC_IDP'")
+	}
 	prompt, ok := d.GetOk("mfa_prompt")
 	if !ok {
 		return nil
 	}
+	if !d.Get("mfa_required").(bool) {
+		return errors.New("'mfa_prompt' can only be set when 'mfa_required' is true")
+	}
 	if prompt.(string) != "DEVICE" {
 		d, ok := d.GetOk("mfa_remember_device")
 		if ok && d.(bool) {
 			return errors.New("'mfa_remember_device' can only be set when mfa_prompt='DEVICE'")
 		}
 	}
-	ip, ok := d.GetOk("identity_provider")
-	if ok && ip == "SPECIFIC_IDP" && len(convertInterfaceToStringArrNullable(d.Get("identity_provider_ids"))) < 1 {
-		return errors.New("'identity_provider_ids' should have at least one element when 'identity_provider' is 'SPECIFIC_IDP'")
+	if _, ok := d.GetOk("mfa_lifetime"); ok && prompt.(string) != "SESSION" {
+		return errors.New("'mfa_lifetime' can only be set when mfa_prompt='SESSION'")
 	}
 	return nil
 }
+
+// signOnPolicyRuleRiskScore returns the configured risk score. When it's not configured, the new rules default to ANY,
+// while the existing ones keep the risk score they have, so it doesn't change without showing a diff. An empty string
+// is allowed in case the RISC_SCORING org feature is disabled, then the condition is omitted.
+func signOnPolicyRuleRiskScore(d *schema.ResourceData) string {
+	raw := d.GetRawConfig()
+	if raw.IsNull() {
+		// the rule is built from the defaults, not from the configuration
+		return "ANY"
+	}
+	for _, key := range []string{"risk_score", "risc_level"} {
+		if v := raw.GetAttr(key); !v.IsNull() {
+			return v.AsString()
+		}
+	}
+	if d.IsNewResource() {
+		return "ANY"
+	}
+	return d.Get("risk_score").(string)
+}
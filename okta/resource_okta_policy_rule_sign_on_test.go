@@ -1,12 +1,15 @@
 package okta
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/terraform-provider-okta/sdk"
 )
 
 func TestAccOktaPolicyRuleSignon_defaultErrors(t *testing.T) {
@@ -26,6 +29,69 @@ func TestAccOktaPolicyRuleSignon_defaultErrors(t *testing.T) {
 	})
 }
 
+func TestAccOktaPolicyRuleSignon_defaultRule(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(policyRuleSignOn)
+	config := mgr.GetFixtures("default_rule.tf", ri, t)
+	updatedConfig := mgr.GetFixtures("default_rule_updated.tf", ri, t)
+	renamedConfig := mgr.GetFixtures("default_rule_renamed.tf", ri, t)
+	removedConfig := mgr.GetFixtures("default_rule_removed.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", policyRuleSignOn)
+	policyName := fmt.Sprintf("%s.test", policySignOn)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createRuleCheckDestroy(policyRuleSignOn),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					ensureRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "Default Rule"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+					resource.TestCheckResourceAttr(resourceName, "session_idle", "240"),
+				),
+			},
+			{
+				Config: updatedConfig,
+				Check: resource.ComposeTestCheckFunc(
+					ensureRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "session_idle", "480"),
+					resource.TestCheckResourceAttr(resourceName, "session_lifetime", "480"),
+				),
+			},
+			{
+				Config:      renamedConfig,
+				ExpectError: regexp.MustCompile("rule can't be renamed"),
+			},
+			{
+				// the default rule can't be deleted, it's reset to the defaults instead
+				Config: removedConfig,
+				Check: func(s *terraform.State) error {
+					policyID := s.RootModule().Resources[policyName].Primary.ID
+					rules, _, err := getSupplementFromMetadata(testAccProvider.Meta()).ListPolicyRules(context.Background(), policyID)
+					if err != nil {
+						return err
+					}
+					for _, rule := range rules {
+						if rule.System == nil || !*rule.System {
+							continue
+						}
+						if session := rule.Actions.SignOn.Session; session.MaxSessionIdleMinutes != 120 || session.MaxSessionLifetimeMinutes != 120 {
+							return fmt.Errorf("default rule was not reset, session idle %d and lifetime %d",
+								session.MaxSessionIdleMinutes, session.MaxSessionLifetimeMinutes)
+						}
+						return nil
+					}
+					return fmt.Errorf("policy '%s' has no default rule", policyID)
+				},
+			},
+		},
+	})
+}
+
 func TestAccOktaPolicyRuleSignon_crud(t *testing.T) {
 	ri := acctest.RandInt()
 	mgr := newFixtureManager(policyRuleSignOn)
@@ -105,6 +171,7 @@ func TestAccOktaPolicyRuleSignon_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
 					resource.TestCheckResourceAttr(resourceName, "access", "CHALLENGE"),
 					resource.TestCheckResourceAttr(resourceName, "behaviors.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "risk_score", "HIGH"),
 					resource.TestCheckResourceAttr(resourceName, "factor_sequence.0.primary_criteria_factor_type", "password"),
 					resource.TestCheckResourceAttr(resourceName, "factor_sequence.0.primary_criteria_provider", "OKTA"),
 					resource.TestCheckResourceAttr(resourceName, "factor_sequence.0.secondary_criteria.0.factor_type", "push"),
@@ -163,3 +230,55 @@ resource "%s" "%s" {
 }
 `, policyRuleSignOn, name)
 }
+
+func TestFlattenSignOnFactorSequence(t *testing.T) {
+	password := sdk.SignOnPolicyRuleSignOnActionsChallengeChainCriteria{Provider: "OKTA", FactorType: "password"}
+	webauthn := sdk.SignOnPolicyRuleSignOnActionsChallengeChainCriteria{Provider: "FIDO", FactorType: "webauthn"}
+	signOn := &sdk.SignOnPolicyRuleSignOnActions{
+		Access: "CHALLENGE",
+		Challenge: &sdk.SignOnPolicyRuleSignOnActionsChallenge{
+			Chain: []sdk.SignOnPolicyRuleSignOnActionsChallengeChain{
+				{
+					Criteria: []sdk.SignOnPolicyRuleSignOnActionsChallengeChainCriteria{password},
+					Next:     []sdk.SignOnPolicyRuleSignOnActionsChallengeChainNext{{Criteria: []sdk.SignOnPolicyRuleSignOnActionsChallengeChainCriteria{webauthn}}},
+				},
+				{},
+				{Criteria: []sdk.SignOnPolicyRuleSignOnActionsChallengeChainCriteria{webauthn}},
+			},
+		},
+	}
+	arr := flattenSignOnFactorSequence(signOn)
+	if len(arr) != 2 {
+		t.Fatalf("expected 2 factor sequences, got %d", len(arr))
+	}
+	first := arr[0].(map[string]interface{})
+	if first["primary_criteria_factor_type"] != "password" || len(first["secondary_criteria"].([]interface{})) != 1 {
+		t.Errorf("unexpected first factor sequence: %v", first)
+	}
+	if _, ok := arr[1].(map[string]interface{})["secondary_criteria"]; ok {
+		t.Errorf("unexpected secondary criteria in the second factor sequence: %v", arr[1])
+	}
+	signOn.Access = "ALLOW"
+	if arr := flattenSignOnFactorSequence(signOn); arr != nil {
+		t.Errorf("expected no factor sequences when access is not CHALLENGE, got %v", arr)
+	}
+}
+
+func TestDefaultRuleTemplate(t *testing.T) {
+	d := resourcePolicySignOnRule().TestResourceData()
+	_ = d.Set("policy_id", "policyID")
+	_ = d.Set("name", "Default Rule")
+	_ = d.Set("session_idle", 480)
+	_ = d.Set("access", "DENY")
+	template, err := defaultRuleTemplate(resourcePolicySignOnRule(), d, buildSignOnPolicyRule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if template.Name != "Default Rule" {
+		t.Errorf("expected default rule, got '%s'", template.Name)
+	}
+	if template.Actions.SignOn.Access != "ALLOW" || template.Actions.SignOn.Session.MaxSessionIdleMinutes != 120 {
+		t.Errorf("expected the defaults of the schema, got access '%s' and session idle %d",
+			template.Actions.SignOn.Access, template.Actions.SignOn.Session.MaxSessionIdleMinutes)
+	}
+}
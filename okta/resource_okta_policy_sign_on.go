@@ -15,10 +15,8 @@ func resourcePolicySignOn() *schema.Resource {
 		ReadContext:   resourcePolicySignOnRead,
 		UpdateContext: resourcePolicySignOnUpdate,
 		DeleteContext: resourcePolicySignOnDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		Schema: basePolicySchema,
+		Importer:      createPolicyImporter(sdk.SignOnPolicyType),
+		Schema:        basePolicySchema,
 	}
 }
 
@@ -68,6 +68,12 @@ func TestAccOktaPolicySignOn_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", "Terraform Acceptance Test SignOn Policy"),
 				),
 			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"deactivate_on_destroy"},
+			},
 		},
 	})
 }
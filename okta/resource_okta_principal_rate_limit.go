@@ -0,0 +1,147 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+	"github.com/okta/terraform-provider-okta/sdk"
+)
+
+func resourcePrincipalRateLimit() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePrincipalRateLimitCreate,
+		ReadContext:   resourcePrincipalRateLimitRead,
+		UpdateContext: resourcePrincipalRateLimitUpdate,
+		// the settings can't be deleted, so the configured percentages stay in effect after destroy
+		DeleteContext: resourceFuncNoOp,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Description: "Manages the percentages of the org rate limits, which can be consumed by the API token or the OAuth 2.0 app",
+		Schema: map[string]*schema.Schema{
+			"principal_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the API token or client ID of the OAuth 2.0 app",
+			},
+			"principal_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: elemInSlice([]string{sdk.PrincipalTypeSSWSToken, sdk.PrincipalTypeOAuthClient}),
+				Description:      "Type of the principal: SSWS_TOKEN or OAUTH_CLIENT",
+			},
+			"default_percentage": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: intBetween(0, 100),
+				Description:      "Percentage of the rate limit capacity of each API bucket, which can be consumed by the principal",
+			},
+			"default_concurrency_percentage": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: intBetween(0, 100),
+				Description:      "Percentage of the concurrent rate limit capacity, which can be consumed by the principal",
+			},
+		},
+	}
+}
+
+func resourcePrincipalRateLimitCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	existing, err := findPrincipalRateLimit(ctx, m, d.Get("principal_type").(string), d.Get("principal_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// the settings can't be deleted, so the existing ones are updated instead of creating the duplicate
+	if existing != nil {
+		_, _, err = getSupplementFromMetadata(m).UpdatePrincipalRateLimit(ctx, existing.Id, buildPrincipalRateLimit(d))
+		if err != nil {
+			return diag.Errorf("failed to update principal rate limit: %v", err)
+		}
+		d.SetId(existing.Id)
+		return resourcePrincipalRateLimitRead(ctx, d, m)
+	}
+	rateLimit, _, err := getSupplementFromMetadata(m).CreatePrincipalRateLimit(ctx, buildPrincipalRateLimit(d))
+	if err != nil {
+		return diag.Errorf("failed to create principal rate limit: %v", err)
+	}
+	d.SetId(rateLimit.Id)
+	return resourcePrincipalRateLimitRead(ctx, d, m)
+}
+
+func resourcePrincipalRateLimitRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	rateLimit, resp, err := getSupplementFromMetadata(m).GetPrincipalRateLimit(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get principal rate limit: %v", err)
+	}
+	if rateLimit == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("principal_id", rateLimit.PrincipalId)
+	_ = d.Set("principal_type", rateLimit.PrincipalType)
+	if rateLimit.DefaultPercentage != nil {
+		_ = d.Set("default_percentage", *rateLimit.DefaultPercentage)
+	}
+	if rateLimit.DefaultConcurrencyPercentage != nil {
+		_ = d.Set("default_concurrency_percentage", *rateLimit.DefaultConcurrencyPercentage)
+	}
+	return nil
+}
+
+func resourcePrincipalRateLimitUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, _, err := getSupplementFromMetadata(m).UpdatePrincipalRateLimit(ctx, d.Id(), buildPrincipalRateLimit(d))
+	if err != nil {
+		return diag.Errorf("failed to update principal rate limit: %v", err)
+	}
+	return resourcePrincipalRateLimitRead(ctx, d, m)
+}
+
+func buildPrincipalRateLimit(d *schema.ResourceData) sdk.PrincipalRateLimit {
+	return sdk.PrincipalRateLimit{
+		PrincipalId:                  d.Get("principal_id").(string),
+		PrincipalType:                d.Get("principal_type").(string),
+		DefaultPercentage:            principalRateLimitPercentage(d, "default_percentage"),
+		DefaultConcurrencyPercentage: principalRateLimitPercentage(d, "default_concurrency_percentage"),
+	}
+}
+
+// principalRateLimitPercentage returns nil when the attribute is absent from the configuration, so that 0
+// can be sent to the API, while the absent percentages are left to the Okta defaults
+func principalRateLimitPercentage(d *schema.ResourceData, key string) *int64 {
+	v := d.GetRawConfig().GetAttr(key)
+	if v.IsNull() {
+		return nil
+	}
+	percentage, _ := v.AsBigFloat().Int64()
+	return &percentage
+}
+
+func findPrincipalRateLimit(ctx context.Context, m interface{}, principalType, principalID string) (*sdk.PrincipalRateLimit, error) {
+	qp := &query.Params{Filter: fmt.Sprintf("principalType eq \"%s\"", principalType), Limit: defaultPaginationLimit}
+	rateLimits, resp, err := getSupplementFromMetadata(m).ListPrincipalRateLimits(ctx, qp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list principal rate limits: %v", err)
+	}
+	for {
+		for _, rateLimit := range rateLimits {
+			if rateLimit.PrincipalId == principalID {
+				return rateLimit, nil
+			}
+		}
+		if !resp.HasNextPage() {
+			return nil, nil
+		}
+		rateLimits = nil
+		resp, err = resp.Next(ctx, &rateLimits)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list principal rate limits: %v", err)
+		}
+	}
+}
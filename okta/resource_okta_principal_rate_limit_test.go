@@ -0,0 +1,48 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+func TestAccOktaPrincipalRateLimit_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(principalRateLimit)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", principalRateLimit)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      createCheckResourceDestroy(appOAuth, createDoesAppExist(okta.NewOpenIdConnectApplication())),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "principal_id", "okta_app_oauth.test", "client_id"),
+					resource.TestCheckResourceAttr(resourceName, "principal_type", "OAUTH_CLIENT"),
+					resource.TestCheckResourceAttr(resourceName, "default_percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "default_concurrency_percentage", "25"),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_percentage", "30"),
+					resource.TestCheckResourceAttr(resourceName, "default_concurrency_percentage", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -50,21 +50,26 @@ func resourceRateLimitingCreate(ctx context.Context, d *schema.ResourceData, m i
 		return diag.Errorf("failed to set rate limiting communications: %v", err)
 	}
 	d.SetId("rate_limiting")
-	return nil
+	return resourceRateLimitingRead(ctx, d, m)
 }
 
 func resourceRateLimitingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	rl, _, err := getSupplementFromMetadata(m).GetClientBasedRateLimiting(ctx)
-	if err != nil || rl.GranularModeSettings == nil {
+	if err != nil {
 		return diag.Errorf("failed to get client-based rate limiting: %v", err)
 	}
+	if rl.GranularModeSettings == nil {
+		return diag.Errorf("failed to get client-based rate limiting: granular mode settings are missing in the response")
+	}
 	_ = d.Set("login", rl.GranularModeSettings.LoginPage)
 	_ = d.Set("authorize", rl.GranularModeSettings.OAuth2Authorize)
 	comm, _, err := getSupplementFromMetadata(m).GetRateLimitingCommunications(ctx)
 	if err != nil {
 		return diag.Errorf("failed to get rate limiting communications: %v", err)
 	}
-	_ = d.Set("communications_enabled", *comm.RateLimitNotification)
+	if comm.RateLimitNotification != nil {
+		_ = d.Set("communications_enabled", *comm.RateLimitNotification)
+	}
 	d.SetId("rate_limiting")
 	return nil
 }
@@ -78,7 +83,7 @@ func resourceRateLimitingUpdate(ctx context.Context, d *schema.ResourceData, m i
 	if err != nil {
 		return diag.Errorf("failed to set rate limiting communications: %v", err)
 	}
-	return nil
+	return resourceRateLimitingRead(ctx, d, m)
 }
 
 func buildRateLimiter(d *schema.ResourceData) sdk.ClientRateLimitMode {
@@ -13,6 +13,7 @@ func TestAccOktaRateLimiting_crud(t *testing.T) {
 	resourceName := fmt.Sprintf("%s.example", rateLimiting)
 	mgr := newFixtureManager(rateLimiting)
 	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          testAccPreCheck(t),
@@ -28,6 +29,14 @@ func TestAccOktaRateLimiting_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "communications_enabled", "true"),
 				),
 			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "login", "PREVIEW"),
+					resource.TestCheckResourceAttr(resourceName, "authorize", "DISABLE"),
+					resource.TestCheckResourceAttr(resourceName, "communications_enabled", "false"),
+				),
+			},
 		},
 	})
 }
@@ -9,6 +9,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// validSubscriptionRoles the standard admin roles, custom roles can't be subscribed to the notifications
+var validSubscriptionRoles = []string{
+	"API_ACCESS_MANAGEMENT_ADMIN",
+	"APP_ADMIN",
+	"GROUP_MEMBERSHIP_ADMIN",
+	"HELP_DESK_ADMIN",
+	"MOBILE_ADMIN",
+	"ORG_ADMIN",
+	"READ_ONLY_ADMIN",
+	"REPORT_ADMIN",
+	"SUPER_ADMIN",
+	"USER_ADMIN",
+}
+
 func resourceRoleSubscription() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceRoleSubscriptionCreate,
@@ -33,19 +47,8 @@ func resourceRoleSubscription() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 				// https://developer.okta.com/docs/reference/api/admin-notifications/#role-types
-				ValidateDiagFunc: elemInSlice([]string{
-					"API_ADMIN",
-					"APP_ADMIN",
-					"GROUP_MEMBERSHIP_ADMIN",
-					"HELP_DESK_ADMIN",
-					"MOBILE_ADMIN",
-					"ORG_ADMIN",
-					"READ_ONLY_ADMIN",
-					"REPORT_ADMIN",
-					"SUPER_ADMIN",
-					"USER_ADMIN",
-				}),
-				Description: "Type of the role",
+				ValidateDiagFunc: elemInSlice(validSubscriptionRoles),
+				Description:      "Type of the role",
 			},
 			"notification_type": {
 				Type:             schema.TypeString,
@@ -57,8 +60,9 @@ func resourceRoleSubscription() *schema.Resource {
 			"status": {
 				Type:             schema.TypeString,
 				Optional:         true,
+				Computed:         true,
 				ValidateDiagFunc: elemInSlice([]string{"subscribed", "unsubscribed"}),
-				Description:      "Status of subscription",
+				Description:      "Status of subscription. If not set, the current status of the subscription is kept",
 			},
 		},
 	}
@@ -71,6 +75,7 @@ func resourceRoleSubscriptionCreate(ctx context.Context, d *schema.ResourceData,
 	}
 	status, ok := d.GetOk("status")
 	if !ok {
+		d.SetId(d.Get("notification_type").(string))
 		return resourceRoleSubscriptionRead(ctx, d, m)
 	}
 	subscription, _, err := getOktaClientFromMetadata(m).Subscription.GetRoleSubscriptionByNotificationType(ctx, d.Get("role_type").(string), d.Get("notification_type").(string))
@@ -92,8 +97,8 @@ func resourceRoleSubscriptionCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceRoleSubscriptionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	subscription, _, err := getOktaClientFromMetadata(m).Subscription.GetRoleSubscriptionByNotificationType(ctx, d.Get("role_type").(string), d.Get("notification_type").(string))
-	if err != nil {
+	subscription, resp, err := getOktaClientFromMetadata(m).Subscription.GetRoleSubscriptionByNotificationType(ctx, d.Get("role_type").(string), d.Get("notification_type").(string))
+	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed get subscription: %v", err)
 	}
 	if subscription == nil {
@@ -109,10 +114,10 @@ func resourceRoleSubscriptionUpdate(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	oldStatus, newStatus := d.GetChange("status")
-	if oldStatus == newStatus {
+	if !d.HasChange("status") {
 		return nil
 	}
+	newStatus := d.Get("status").(string)
 	if newStatus == "subscribed" {
 		_, err = getOktaClientFromMetadata(m).Subscription.SubscribeRoleSubscriptionByNotificationType(ctx, d.Get("role_type").(string), d.Get("notification_type").(string))
 	} else {
@@ -121,7 +126,7 @@ func resourceRoleSubscriptionUpdate(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.Errorf("failed to change subscription: %v", err)
 	}
-	return nil
+	return resourceRoleSubscriptionRead(ctx, d, m)
 }
 
 func validateSubscriptions(role, notification string) error {
@@ -150,5 +155,5 @@ func validateSubscriptions(role, notification string) error {
 	case notification == "OKTA_ANNOUNCEMENT" || notification == "OKTA_ISSUE" || notification == "OKTA_UPDATE":
 		return nil
 	}
-	return fmt.Errorf("'%s' notification is not aplicable for the '%s' role", notification, role)
+	return fmt.Errorf("'%s' notification is not applicable for the '%s' role", notification, role)
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccOktaRoleSubscription_crud(t *testing.T) {
@@ -13,6 +14,7 @@ func TestAccOktaRoleSubscription_crud(t *testing.T) {
 	resourceName := fmt.Sprintf("%s.test", roleSubscription)
 	mgr := newFixtureManager(roleSubscription)
 	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          testAccPreCheck(t),
@@ -25,6 +27,49 @@ func TestAccOktaRoleSubscription_crud(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "status", "unsubscribed")),
 			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", "subscribed")),
+			},
+			{
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("failed to find %s", resourceName)
+					}
+					return fmt.Sprintf("%s/%s", rs.Primary.Attributes["role_type"], rs.Primary.Attributes["notification_type"]), nil
+				},
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
+
+func TestValidateSubscriptions(t *testing.T) {
+	tests := []struct {
+		role         string
+		notification string
+		valid        bool
+	}{
+		{"SUPER_ADMIN", "OKTA_ANNOUNCEMENT", true},
+		{"READ_ONLY_ADMIN", "OKTA_ISSUE", true},
+		{"APP_ADMIN", "APP_IMPORT", true},
+		{"HELP_DESK_ADMIN", "USER_LOCKED_OUT", true},
+		{"API_ACCESS_MANAGEMENT_ADMIN", "USER_DEPROVISION", true},
+		{"REPORT_ADMIN", "APP_IMPORT", false},
+		{"ORG_ADMIN", "RATELIMIT_NOTIFICATION", false},
+		{"USER_ADMIN", "REPORT_SUSPICIOUS_ACTIVITY", false},
+	}
+	for _, test := range tests {
+		err := validateSubscriptions(test.role, test.notification)
+		if test.valid && err != nil {
+			t.Errorf("expected '%s' notification to be applicable for the '%s' role: %v", test.notification, test.role, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("expected '%s' notification not to be applicable for the '%s' role", test.notification, test.role)
+		}
+	}
+}
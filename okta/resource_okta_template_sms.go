@@ -11,12 +11,14 @@ import (
 var translationSmsResource = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"language": {
-			Type:     schema.TypeString,
-			Required: true,
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The language to map the template to",
 		},
 		"template": {
 			Type:             schema.TypeString,
 			Required:         true,
+			Description:      "The SMS message",
 			ValidateDiagFunc: stringLenBetween(1, 161),
 		},
 	},
@@ -33,9 +35,10 @@ func resourceTemplateSms() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"type": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "SMS template type",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "SMS template type",
+				ValidateDiagFunc: elemInSlice([]string{"SMS_VERIFY_CODE"}),
 			},
 			"template": {
 				Type:             schema.TypeString,
@@ -44,9 +47,10 @@ func resourceTemplateSms() *schema.Resource {
 				ValidateDiagFunc: stringLenBetween(1, 161),
 			},
 			"translations": {
-				Type:     schema.TypeSet,
-				Optional: true,
-				Elem:     translationSmsResource,
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of translations for particular template",
+				Elem:        translationSmsResource,
 			},
 		},
 	}
@@ -71,8 +75,15 @@ func resourceTemplateSmsRead(ctx context.Context, d *schema.ResourceData, m inte
 		d.SetId("")
 		return nil
 	}
+	_ = d.Set("type", temp.Type)
+	_ = d.Set("template", temp.Template)
 	if temp.Translations != nil {
-		_ = d.Set("translations", flattenSmsTranslations(*temp.Translations))
+		err = setNonPrimitives(d, map[string]interface{}{
+			"translations": flattenSmsTranslations(*temp.Translations),
+		})
+		if err != nil {
+			return diag.Errorf("failed to set SMS template translations: %v", err)
+		}
 	}
 	return nil
 }
@@ -87,8 +98,8 @@ func resourceTemplateSmsUpdate(ctx context.Context, d *schema.ResourceData, m in
 }
 
 func resourceTemplateSmsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	_, err := getOktaClientFromMetadata(m).SmsTemplate.DeleteSmsTemplate(ctx, d.Id())
-	if err != nil {
+	resp, err := getOktaClientFromMetadata(m).SmsTemplate.DeleteSmsTemplate(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to delete SMS template: %v", err)
 	}
 	return nil
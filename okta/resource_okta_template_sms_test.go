@@ -44,6 +44,11 @@ func TestAccOktaSmsTemplate_crud(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "translations.2.language", "fr"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
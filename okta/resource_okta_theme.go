@@ -67,10 +67,14 @@ func resourceThemeRead(ctx context.Context, d *schema.ResourceData, m interface{
 	}
 	brandID := bid.(string)
 
-	theme, _, err := getOktaClientFromMetadata(m).Brand.GetBrandTheme(ctx, brandID, d.Id())
-	if err != nil {
+	theme, resp, err := getOktaClientFromMetadata(m).Brand.GetBrandTheme(ctx, brandID, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to get theme: %v", err)
 	}
+	if theme == nil {
+		d.SetId("")
+		return nil
+	}
 
 	rawMap := flattenTheme(brandID, theme)
 	err = setNonPrimitives(d, rawMap)
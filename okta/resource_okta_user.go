@@ -2,6 +2,8 @@ package okta
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -58,6 +60,15 @@ func resourceUser() *schema.Resource {
 		ReadContext:   resourceUserRead,
 		UpdateContext: resourceUserUpdate,
 		DeleteContext: resourceUserDelete,
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+			// the temporary password is generated only on creation
+			if d.Id() != "" || !d.Get("generate_temporary_password").(bool) {
+				return nil
+			}
+			_, passwordSet := d.GetOk("password")
+			_, hashSet := d.GetOk("password_hash")
+			return validateTemporaryPasswordGeneration(d.Get("status").(string), passwordSet || hashSet)
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
 				// Supporting id and email based imports
@@ -106,7 +117,17 @@ func resourceUser() *schema.Resource {
 				StateFunc:        normalizeDataJSON,
 				Description:      "JSON formatted custom attributes for a user. It must be JSON due to various types Okta allows.",
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					return new == ""
+					if new == "" {
+						return true
+					}
+
+					var oldCustomAttrs map[string]interface{}
+					_ = json.Unmarshal([]byte(old), &oldCustomAttrs)
+
+					var newCustomAttrs map[string]interface{}
+					_ = json.Unmarshal([]byte(new), &newCustomAttrs)
+
+					return reflect.DeepEqual(normalizeUserCustomAttributes(oldCustomAttrs), normalizeUserCustomAttributes(newCustomAttrs))
 				},
 			},
 			"department": {
@@ -239,11 +260,13 @@ func resourceUser() *schema.Resource {
 				Optional:         true,
 				Description:      "The status of the User in Okta - remove to set user back to active/provisioned",
 				Default:          statusActive,
-				ValidateDiagFunc: elemInSlice([]string{statusActive, userStatusStaged, userStatusDeprovisioned, userStatusSuspended}),
+				ValidateDiagFunc: elemInSlice([]string{statusActive, userStatusStaged, userStatusProvisioned, userStatusDeprovisioned, userStatusSuspended}),
 				// ignore diff changing to ACTIVE if state is set to PROVISIONED or PASSWORD_EXPIRED
-				// since this is a similar status in Okta terms
+				// since this is a similar status in Okta terms, and changing to PROVISIONED if state is set
+				// to ACTIVE, since the PROVISIONED user becomes ACTIVE once it finishes the activation
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					return old == userStatusProvisioned && new == statusActive || old == userStatusPasswordExpired && new == statusActive
+					return old == userStatusProvisioned && new == statusActive || old == userStatusPasswordExpired && new == statusActive ||
+						old == statusActive && new == userStatusProvisioned
 				},
 			},
 			"raw_status": {
@@ -289,6 +312,44 @@ func resourceUser() *schema.Resource {
 				Description:  "If set to `true`, the user will have to change the password at the next login. This property will be used when user is being created and works only when `password` field is set",
 				RequiredWith: []string{"password"},
 			},
+			"clear_sessions_on_credential_change": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to `true`, all the active sessions of the user will be cleared when the password or the status of the user changes",
+			},
+			"revoke_oauth_tokens": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				Description:  "If set to `true`, the OAuth and OpenID Connect tokens issued to the user will be revoked as well when the sessions are cleared",
+				RequiredWith: []string{"clear_sessions_on_credential_change"},
+			},
+			"deprovisioned_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to `true`, the user will only be deactivated on destroy instead of being deleted",
+			},
+			"send_email_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to `true`, Okta will send the deactivation email to the admin on destroy",
+			},
+			"generate_temporary_password": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				Description:   "If set to `true`, the user's password will be expired and reset to a temporary password on creation, which is exposed in the `temporary_password` attribute. Requires `password` or `password_hash` and the `ACTIVE` status. It's used only on creation, changing it afterwards has no effect",
+				ConflictsWith: []string{"password_inline_hook", "expire_password_on_create"},
+			},
+			"temporary_password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Temporary password generated on creation when `generate_temporary_password` is set to `true`",
+			},
 			"password_inline_hook": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -303,9 +364,10 @@ func resourceUser() *schema.Resource {
 				Description: "Old User Password. Should be only set in case the password was not changed using the provider",
 			},
 			"recovery_question": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "User Password Recovery Question",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "User Password Recovery Question",
+				RequiredWith: []string{"recovery_answer"},
 			},
 			"recovery_answer": {
 				Type:             schema.TypeString,
@@ -313,12 +375,14 @@ func resourceUser() *schema.Resource {
 				Sensitive:        true,
 				ValidateDiagFunc: stringLenBetween(4, 1000),
 				Description:      "User Password Recovery Answer",
+				RequiredWith:     []string{"recovery_question"},
 			},
 			"password_hash": {
-				Type:        schema.TypeSet,
-				MaxItems:    1,
-				Description: "Specifies a hashed password to import into Okta.",
-				Optional:    true,
+				Type:          schema.TypeSet,
+				MaxItems:      1,
+				Description:   "Specifies a hashed password to import into Okta.",
+				Optional:      true,
+				ConflictsWith: []string{"password"},
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					oldHash, newHash := d.GetChange("password_hash")
 					if oldHash != nil && newHash != nil && len(oldHash.(*schema.Set).List()) > 0 && len(newHash.(*schema.Set).List()) > 0 {
@@ -370,11 +434,16 @@ func resourceUser() *schema.Resource {
 
 func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	logger(m).Info("creating user", "login", d.Get("login").(string))
+	err := validatePasswordHash(d.Get("password_hash"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	profile := populateUserProfile(d)
 	qp := query.NewQueryParams()
 
-	// setting activate to false on user creation will leave the user with a status of STAGED
-	if d.Get("status").(string) == userStatusStaged {
+	// setting activate to false on user creation will leave the user with a status of STAGED,
+	// PROVISIONED users are activated afterwards without sending the activation email
+	if d.Get("status").(string) == userStatusStaged || d.Get("status").(string) == userStatusProvisioned {
 		qp = query.NewQueryParams(query.WithActivate(false))
 	}
 
@@ -435,7 +504,8 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 	}
 
 	// status changing can only happen after user is created as well
-	if d.Get("status").(string) == userStatusSuspended || d.Get("status").(string) == userStatusDeprovisioned {
+	if d.Get("status").(string) == userStatusSuspended || d.Get("status").(string) == userStatusDeprovisioned ||
+		d.Get("status").(string) == userStatusProvisioned {
 		err := updateUserStatus(ctx, user.Id, d.Get("status").(string), client)
 		if err != nil {
 			return diag.Errorf("failed to update user status: %v", err)
@@ -450,6 +520,14 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 		}
 	}
 
+	if d.Get("generate_temporary_password").(bool) {
+		tp, _, err := client.User.ExpirePasswordAndGetTemporaryPassword(ctx, user.Id)
+		if err != nil {
+			return diag.Errorf("failed to generate user's temporary password: %v", err)
+		}
+		_ = d.Set("temporary_password", tp.TempPassword)
+	}
+
 	return resourceUserRead(ctx, d, m)
 }
 
@@ -503,6 +581,17 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	recoveryQuestionChange := d.HasChange("recovery_question")
 	recoveryAnswerChange := d.HasChange("recovery_answer")
 
+	if passwordHookChange && d.Get("password_inline_hook").(string) != "" && d.Get("raw_status").(string) != userStatusStaged {
+		return diag.Errorf("'password_inline_hook' can only be set on creation or for a user in the STAGED status")
+	}
+
+	if passwordHashChange {
+		err := validatePasswordHash(d.Get("password_hash"))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	client := getOktaClientFromMetadata(m)
 	if passwordChange {
 		user, _, err := client.User.GetUser(ctx, d.Id())
@@ -636,26 +725,56 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 	}
 
 	if recoveryQuestionChange || recoveryAnswerChange {
-		nuc := &okta.UserCredentials{
-			Password: &okta.PasswordCredential{
-				Value: d.Get("password").(string),
-			},
-			RecoveryQuestion: &okta.RecoveryQuestionCredential{
-				Question: d.Get("recovery_question").(string),
-				Answer:   d.Get("recovery_answer").(string),
-			},
+		rq := &okta.RecoveryQuestionCredential{
+			Question: d.Get("recovery_question").(string),
+			Answer:   d.Get("recovery_answer").(string),
+		}
+		var err error
+		// changing the recovery question requires the current password, without it the question is set
+		// through the user's credentials as an admin operation
+		if password := d.Get("password").(string); password != "" {
+			_, _, err = client.User.ChangeRecoveryQuestion(ctx, d.Id(), okta.UserCredentials{
+				Password:         &okta.PasswordCredential{Value: password},
+				RecoveryQuestion: rq,
+			})
+		} else {
+			_, _, err = client.User.UpdateUser(ctx, d.Id(), okta.User{
+				Profile:     populateUserProfile(d),
+				Credentials: &okta.UserCredentials{RecoveryQuestion: rq},
+			}, nil)
 		}
-		_, _, err := client.User.ChangeRecoveryQuestion(ctx, d.Id(), *nuc)
 		if err != nil {
 			return diag.Errorf("failed to change user's password recovery question: %v", err)
 		}
 	}
+
+	// deprovisioned users have their sessions cleared by Okta
+	credentialChange := passwordChange || passwordHashChange || passwordHookChange || statusChange
+	if credentialChange && d.Get("clear_sessions_on_credential_change").(bool) && status != userStatusDeprovisioned {
+		qp := query.NewQueryParams(query.WithOauthTokens(d.Get("revoke_oauth_tokens").(bool)))
+		_, err := client.User.ClearUserSessions(ctx, d.Id(), qp)
+		if err != nil {
+			return diag.Errorf("failed to clear user's sessions: %v", err)
+		}
+	}
 	return resourceUserRead(ctx, d, m)
 }
 
 func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	logger(m).Info("deleting user", "id", d.Id())
-	err := ensureUserDelete(ctx, d.Id(), d.Get("status").(string), getOktaClientFromMetadata(m))
+	client := getOktaClientFromMetadata(m)
+	qp := query.NewQueryParams(query.WithSendEmail(d.Get("send_email_on_destroy").(bool)))
+	if d.Get("deprovisioned_on_destroy").(bool) {
+		if d.Get("status").(string) == userStatusDeprovisioned {
+			return nil
+		}
+		resp, err := client.User.DeactivateUser(ctx, d.Id(), qp)
+		if err := suppressErrorOn404(resp, err); err != nil {
+			return diag.Errorf("failed to deprovision user: %v", err)
+		}
+		return nil
+	}
+	err := ensureUserDeleteWithParams(ctx, d.Id(), d.Get("status").(string), client, qp)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -679,6 +798,39 @@ func buildPasswordCredentialHash(rawPasswordHash interface{}) *okta.PasswordCred
 	return h
 }
 
+// validatePasswordHash checks the hash attributes required by the chosen algorithm, since the schema can't express them
+func validatePasswordHash(rawPasswordHash interface{}) error {
+	h := buildPasswordCredentialHash(rawPasswordHash)
+	if h == nil {
+		return nil
+	}
+	if h.Algorithm == "BCRYPT" {
+		if h.WorkFactor == 0 {
+			return errors.New("'work_factor' is required for the 'BCRYPT' password hash algorithm")
+		}
+		if len(h.Salt) != 22 {
+			return errors.New("'salt' of 22 characters is required for the 'BCRYPT' password hash algorithm")
+		}
+		return nil
+	}
+	if h.Salt != "" && h.SaltOrder == "" {
+		return fmt.Errorf("'salt_order' is required when 'salt' is set for the '%s' password hash algorithm", h.Algorithm)
+	}
+	return nil
+}
+
+// validateTemporaryPasswordGeneration only the password of the active user can be expired, and the user created
+// without a password remains PROVISIONED after the activation
+func validateTemporaryPasswordGeneration(status string, hasPassword bool) error {
+	if status != statusActive {
+		return fmt.Errorf("'generate_temporary_password' can't be used for the user with the '%s' status, the password can be expired only for the '%s' user", status, statusActive)
+	}
+	if !hasPassword {
+		return errors.New("'generate_temporary_password' requires 'password' or 'password_hash', the user created without a password is 'PROVISIONED' and its password can't be expired")
+	}
+	return nil
+}
+
 // Checks whether any profile keys have changed, this is necessary since the profile is not nested. Also, necessary
 // to give a sensible user readable error when they attempt to update a DEPROVISIONED user. Previously
 // this error always occurred when you set a user's status to DEPROVISIONED.
@@ -692,6 +844,10 @@ func hasProfileChange(d *schema.ResourceData) bool {
 }
 
 func ensureUserDelete(ctx context.Context, id, status string, client *okta.Client) error {
+	return ensureUserDeleteWithParams(ctx, id, status, client, nil)
+}
+
+func ensureUserDeleteWithParams(ctx context.Context, id, status string, client *okta.Client, qp *query.Params) error {
 	// only deprovisioned users can be deleted fully from okta
 	// make two passes on the user if they aren't deprovisioned already to deprovision them first
 	passes := 2
@@ -699,7 +855,7 @@ func ensureUserDelete(ctx context.Context, id, status string, client *okta.Clien
 		passes = 1
 	}
 	for i := 0; i < passes; i++ {
-		_, err := client.User.DeactivateOrDeleteUser(ctx, id, nil)
+		_, err := client.User.DeactivateOrDeleteUser(ctx, id, qp)
 		if err != nil {
 			return fmt.Errorf("failed to deprovision or delete user from Okta: %v", err)
 		}
@@ -60,7 +60,15 @@ func resourceUserAdminRolesCreate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceUserAdminRolesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	err := setAdminRoles(ctx, d, m)
+	user, resp, err := getOktaClientFromMetadata(m).User.GetUser(ctx, d.Get("user_id").(string))
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get user: %v", err)
+	}
+	if user == nil {
+		d.SetId("")
+		return nil
+	}
+	err = setAdminRoles(ctx, d, m)
 	if err != nil {
 		return diag.Errorf("failed to set read user's roles: %v", err)
 	}
@@ -105,11 +113,16 @@ func resourceUserAdminRolesUpdate(ctx context.Context, d *schema.ResourceData, m
 func resourceUserAdminRolesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	userID := d.Get("user_id").(string)
 	client := getOktaClientFromMetadata(m)
-	roles, _, err := listUserOnlyRoles(ctx, client, userID)
-	if err != nil {
+	roles, resp, err := listUserOnlyRoles(ctx, client, userID)
+	if err := suppressErrorOn404(resp, err); err != nil {
 		return diag.Errorf("failed to list user's roles: %v", err)
 	}
+	// only remove the roles managed by this resource
+	managedRoles := convertInterfaceToStringSet(d.Get("admin_roles"))
 	for _, role := range roles {
+		if !contains(managedRoles, role.Type) {
+			continue
+		}
 		resp, err := client.User.RemoveRoleFromUser(ctx, userID, role.Id)
 		if err := suppressErrorOn404(resp, err); err != nil {
 			return diag.Errorf("failed to remove user's role: %v", err)
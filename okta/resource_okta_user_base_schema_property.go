@@ -44,6 +44,7 @@ func resourceUserBaseSchemaProperty() *schema.Resource {
 					Default:          "PROFILE_MASTER",
 				},
 			},
+			userMasterOverridePrioritySchema,
 		),
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -120,6 +121,9 @@ func updateUserBaseSubschema(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func validateUserBaseSchema(d *schema.ResourceData) error {
+	if err := validateMasterOverridePriority(d); err != nil {
+		return err
+	}
 	_, ok := d.GetOk("pattern")
 	if d.Get("index").(string) != "login" {
 		if ok {
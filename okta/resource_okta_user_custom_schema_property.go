@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -55,25 +56,8 @@ func resourceUserCustomSchemaProperty() *schema.Resource {
 					Description:      "SubSchema profile manager, if not set it will inherit its setting.",
 					Default:          "PROFILE_MASTER",
 				},
-				"master_override_priority": {
-					Type:        schema.TypeList,
-					Optional:    true,
-					Description: "",
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"type": {
-								Type:     schema.TypeString,
-								Optional: true,
-								Default:  "APP",
-							},
-							"value": {
-								Type:     schema.TypeString,
-								Required: true,
-							},
-						},
-					},
-				},
 			},
+			userMasterOverridePrioritySchema,
 		),
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -203,6 +187,19 @@ func resourceUserSchemaDelete(ctx context.Context, d *schema.ResourceData, m int
 }
 
 func validateUserSchema(d *schema.ResourceData) error {
+	if d.Get("type").(string) == "array" && d.Get("array_type").(string) == "" {
+		return errors.New("'array_type' is required when 'type' is set to 'array'")
+	}
+	if err := validateOneOfInEnum(d, "one_of", "enum"); err != nil {
+		return err
+	}
+	if err := validateOneOfInEnum(d, "array_one_of", "array_enum"); err != nil {
+		return err
+	}
+	return validateMasterOverridePriority(d)
+}
+
+func validateMasterOverridePriority(d *schema.ResourceData) error {
 	v, ok := d.GetOk("master")
 	if !ok || v.(string) != "OVERRIDE" {
 		return nil
@@ -213,3 +210,36 @@ func validateUserSchema(d *schema.ResourceData) error {
 	}
 	return nil
 }
+
+// validateOneOfInEnum Okta requires each of the display names consts to be one of the enum values when both are set
+func validateOneOfInEnum(d *schema.ResourceData, oneOfKey, enumKey string) error {
+	enum := convertInterfaceToStringArrNullable(d.Get(enumKey))
+	oneOf, _ := d.Get(oneOfKey).([]interface{})
+	if len(enum) == 0 || len(oneOf) == 0 {
+		return nil
+	}
+	for i := range oneOf {
+		c := oneOf[i].(map[string]interface{})["const"].(string)
+		if !enumContains(enum, c) {
+			return fmt.Errorf("'%s' const '%s' is missing from the '%s' values", oneOfKey, c, enumKey)
+		}
+	}
+	return nil
+}
+
+// enumContains compares numbers by their value, since enums are kept as strings in the state
+func enumContains(enum []string, value string) bool {
+	if contains(enum, value) {
+		return true
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return false
+	}
+	for _, e := range enum {
+		if ef, err := strconv.ParseFloat(e, 64); err == nil && ef == f {
+			return true
+		}
+	}
+	return false
+}
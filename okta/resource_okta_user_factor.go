@@ -0,0 +1,187 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+const (
+	userFactorTypeSMS   = "sms"
+	userFactorTypeCall  = "call"
+	userFactorTypeEmail = "email"
+	userFactorTypeTOTP  = "token:software:totp"
+)
+
+// userFactorEnrollment is a generic user factor, since the profile differs between the factor types
+type userFactorEnrollment struct {
+	okta.UserFactor
+	Profile map[string]interface{} `json:"profile,omitempty"`
+}
+
+func resourceUserFactor() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserFactorCreate,
+		ReadContext:   resourceUserFactorRead,
+		DeleteContext: resourceUserFactorDelete,
+		Importer:      createNestedResourceImporter([]string{"user_id", "id"}),
+		Description:   "Resource to enroll a factor for a user",
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of a Okta User",
+				ForceNew:    true,
+			},
+			"factor_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Type of the factor to enroll",
+				ForceNew:         true,
+				ValidateDiagFunc: elemInSlice([]string{userFactorTypeSMS, userFactorTypeCall, userFactorTypeEmail, userFactorTypeTOTP}),
+			},
+			"provider_name": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "OKTA",
+				Description:      "Provider of the factor. GOOGLE is only supported for the TOTP factor",
+				ForceNew:         true,
+				ValidateDiagFunc: elemInSlice([]string{"OKTA", "GOOGLE"}),
+			},
+			"phone_number": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Phone number of the SMS or call factor",
+				ForceNew:    true,
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Email of the email factor. Defaults to the primary email of the user",
+				ForceNew:    true,
+			},
+			"activate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to activate the SMS, call or email factor on enrollment without verification",
+				ForceNew:    true,
+			},
+			"token_lifetime_seconds": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Description:      "Lifetime of the verification email sent for the email factor which is not activated on enrollment",
+				ForceNew:         true,
+				ValidateDiagFunc: intBetween(1, 86400),
+			},
+			"shared_secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Shared secret of the TOTP factor, it's only available after the enrollment",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "User factor status.",
+			},
+		},
+	}
+}
+
+func resourceUserFactorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	uf, err := buildUserFactor(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var qp *query.Params
+	// TOTP factor can be activated only with the passcode from the user's authenticator app
+	if d.Get("activate").(bool) && uf.FactorType != userFactorTypeTOTP {
+		qp = query.NewQueryParams(query.WithActivate(true))
+	} else if tl, ok := d.GetOk("token_lifetime_seconds"); ok && uf.FactorType == userFactorTypeEmail {
+		// the user receives the verification email with the passcode when the email factor isn't activated
+		qp = query.NewQueryParams(query.WithTokenLifetimeSeconds(int64(tl.(int))))
+	}
+	_, _, err = getOktaClientFromMetadata(m).UserFactor.EnrollFactor(ctx, d.Get("user_id").(string), uf, qp)
+	if err != nil {
+		return diag.Errorf("failed to enroll user factor: %v", err)
+	}
+	d.SetId(uf.Id)
+	_ = d.Set("shared_secret", userFactorSharedSecret(uf))
+	return resourceUserFactorRead(ctx, d, m)
+}
+
+func resourceUserFactorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var uf userFactorEnrollment
+	_, resp, err := getOktaClientFromMetadata(m).UserFactor.GetFactor(ctx, d.Get("user_id").(string), d.Id(), &uf)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get user factor: %v", err)
+	}
+	if uf.Id == "" {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("factor_type", uf.FactorType)
+	_ = d.Set("provider_name", uf.Provider)
+	_ = d.Set("status", uf.Status)
+	switch uf.FactorType {
+	case userFactorTypeSMS, userFactorTypeCall:
+		_ = d.Set("phone_number", uf.Profile["phoneNumber"])
+	case userFactorTypeEmail:
+		_ = d.Set("email", uf.Profile["email"])
+	}
+	return nil
+}
+
+func resourceUserFactorDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	resp, err := getOktaClientFromMetadata(m).UserFactor.DeleteFactor(ctx, d.Get("user_id").(string), d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to delete user factor: %v", err)
+	}
+	return nil
+}
+
+func buildUserFactor(d *schema.ResourceData) (*userFactorEnrollment, error) {
+	factorType := d.Get("factor_type").(string)
+	provider := d.Get("provider_name").(string)
+	uf := &userFactorEnrollment{
+		UserFactor: okta.UserFactor{
+			FactorType: factorType,
+			Provider:   provider,
+		},
+	}
+	if provider == "GOOGLE" && factorType != userFactorTypeTOTP {
+		return nil, fmt.Errorf("'GOOGLE' provider is only supported for the '%s' factor type", userFactorTypeTOTP)
+	}
+	switch factorType {
+	case userFactorTypeSMS, userFactorTypeCall:
+		phone := d.Get("phone_number").(string)
+		if phone == "" {
+			return nil, fmt.Errorf("'phone_number' is required for the '%s' factor type", factorType)
+		}
+		uf.Profile = map[string]interface{}{"phoneNumber": phone}
+	case userFactorTypeEmail:
+		if email := d.Get("email").(string); email != "" {
+			uf.Profile = map[string]interface{}{"email": email}
+		}
+	}
+	return uf, nil
+}
+
+func userFactorSharedSecret(uf *userFactorEnrollment) string {
+	embedded, ok := uf.Embedded.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	activation, ok := embedded["activation"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	secret, _ := activation["sharedSecret"].(string)
+	return secret
+}
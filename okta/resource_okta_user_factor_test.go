@@ -0,0 +1,34 @@
+package okta
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaUserFactor_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(userFactor)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", userFactor)
+	resource.Test(
+		t, resource.TestCase{
+			PreCheck:          testAccPreCheck(t),
+			ErrorCheck:        testAccErrorChecks(t),
+			ProviderFactories: testAccProvidersFactories,
+			CheckDestroy:      createUserFactorCheckDestroy(userFactor),
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "factor_type", "email"),
+						resource.TestCheckResourceAttr(resourceName, "provider_name", "OKTA"),
+						resource.TestCheckResourceAttr(resourceName, "email", fmt.Sprintf("john_%d@ledzeppelin.com", ri)),
+						resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					),
+				},
+			},
+		})
+}
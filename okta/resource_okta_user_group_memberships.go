@@ -17,8 +17,19 @@ func resourceUserGroupMemberships() *schema.Resource {
 		ReadContext:   resourceUserGroupMembershipsRead,
 		UpdateContext: resourceUserGroupMembershipsUpdate,
 		DeleteContext: resourceUserGroupMembershipsDelete,
-		Importer:      nil,
-		Description:   "Resource to manage a set of group memberships for a specific user.",
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// all the user's groups are taken under management on import
+				groups, err := listUserChangeableGroupIDs(ctx, getOktaClientFromMetadata(meta), d.Id())
+				if err != nil {
+					return nil, err
+				}
+				_ = d.Set("user_id", d.Id())
+				_ = d.Set("groups", schema.NewSet(schema.HashString, groups))
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Description: "Resource to manage a set of group memberships for a specific user.",
 		Schema: map[string]*schema.Schema{
 			"user_id": {
 				Type:        schema.TypeString,
@@ -66,19 +77,29 @@ func resourceUserGroupMembershipsCreate(ctx context.Context, d *schema.ResourceD
 
 func resourceUserGroupMembershipsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	userId := d.Get("user_id").(string)
-	groups := convertInterfaceToStringSetNullable(d.Get("groups"))
 	client := getOktaClientFromMetadata(m)
-	ok, err := checkIfUserHasGroups(ctx, client, userId, groups)
-	if err != nil {
-		return diag.Errorf("unable to complete group check for user: %v", err)
+	user, resp, err := client.User.GetUser(ctx, userId)
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get user: %v", err)
 	}
-	if ok {
-		return nil
-	} else {
+	if user == nil {
 		d.SetId("")
-		logger(m).Info("user (%s) did not have expected group memberships or did not exist", userId)
 		return nil
 	}
+	userGroups, err := listUserChangeableGroupIDs(ctx, client, userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	managedGroups := convertInterfaceToStringSetNullable(d.Get("groups"))
+	// only keep the managed groups the user is still a member of, so that missing memberships are added back
+	groups := make([]interface{}, 0)
+	for _, group := range userGroups {
+		if contains(managedGroups, group.(string)) {
+			groups = append(groups, group)
+		}
+	}
+	_ = d.Set("groups", schema.NewSet(schema.HashString, groups))
+	return nil
 }
 
 func resourceUserGroupMembershipsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
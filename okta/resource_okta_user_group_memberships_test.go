@@ -24,6 +24,11 @@ func TestAccOktaUserGroupMemberships_crud(t *testing.T) {
 			{
 				Config: start,
 			},
+			{
+				ResourceName:      "okta_user_group_memberships.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: update,
 			},
@@ -0,0 +1,135 @@
+package okta
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+func resourceUserPassword() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserPasswordCreate,
+		ReadContext:   resourceUserPasswordRead,
+		UpdateContext: resourceUserPasswordUpdate,
+		DeleteContext: resourceUserPasswordDelete,
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+			if d.Id() == "" {
+				return nil
+			}
+			return validateUserPasswordRotation(d.HasChange("password"), d.HasChange("old_password"), d.Get("old_password").(string))
+		},
+		Description: "Resource to set or rotate the password of a user independently of the user resource",
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of a Okta User",
+				ForceNew:    true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "New password of the user",
+			},
+			"old_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Current password of the user. If set, the password is changed with the old password validation instead of being set by the admin, in which case it must be changed along with `password`",
+			},
+			"strict": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set to `true`, the password policy (e.g. password history and minimum age) is enforced when the password is changed with the old password",
+			},
+		},
+	}
+}
+
+func resourceUserPasswordCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	userID := d.Get("user_id").(string)
+	err := setUserPassword(ctx, d, m, userID, d.Get("old_password").(string))
+	if err != nil {
+		return err
+	}
+	d.SetId(userID)
+	return resourceUserPasswordRead(ctx, d, m)
+}
+
+func resourceUserPasswordRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	user, resp, err := getOktaClientFromMetadata(m).User.GetUser(ctx, d.Id())
+	if err := suppressErrorOn404(resp, err); err != nil {
+		return diag.Errorf("failed to get user: %v", err)
+	}
+	// the password itself can't be read back, so only the removal of the user or its password is detected
+	if user == nil || user.Credentials == nil || user.Credentials.Password == nil {
+		d.SetId("")
+		return nil
+	}
+	_ = d.Set("user_id", user.Id)
+	return nil
+}
+
+func resourceUserPasswordUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.HasChange("password") {
+		return resourceUserPasswordRead(ctx, d, m)
+	}
+	oldPassword := d.Get("old_password").(string)
+	err := validateUserPasswordRotation(true, d.HasChange("old_password"), oldPassword)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	diags := setUserPassword(ctx, d, m, d.Id(), oldPassword)
+	if diags != nil {
+		return diags
+	}
+	return resourceUserPasswordRead(ctx, d, m)
+}
+
+// validateUserPasswordRotation the old password validation requires the current password of the user, which is
+// not the previous 'old_password' once the password was rotated
+func validateUserPasswordRotation(passwordChanged, oldPasswordChanged bool, oldPassword string) error {
+	if passwordChanged && oldPassword != "" && !oldPasswordChanged {
+		return errors.New("'old_password' must be changed along with 'password' to the current password of the user")
+	}
+	return nil
+}
+
+// resourceUserPasswordDelete the password can't be unset, so it is only removed from the state
+func resourceUserPasswordDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return nil
+}
+
+func setUserPassword(ctx context.Context, d *schema.ResourceData, m interface{}, userID, oldPassword string) diag.Diagnostics {
+	client := getOktaClientFromMetadata(m)
+	password := d.Get("password").(string)
+	if oldPassword != "" {
+		cpr := okta.ChangePasswordRequest{
+			OldPassword: &okta.PasswordCredential{Value: oldPassword},
+			NewPassword: &okta.PasswordCredential{Value: password},
+		}
+		qp := query.NewQueryParams(query.WithStrict(d.Get("strict").(bool)))
+		_, _, err := client.User.ChangePassword(ctx, userID, cpr, qp)
+		if err != nil {
+			return diag.Errorf("failed to change user's password: %v", err)
+		}
+		return nil
+	}
+	user := okta.User{
+		Credentials: &okta.UserCredentials{
+			Password: &okta.PasswordCredential{Value: password},
+		},
+	}
+	// partial update keeps the profile of the user untouched
+	_, _, err := client.User.PartialUpdateUser(ctx, userID, user, nil)
+	if err != nil {
+		return diag.Errorf("failed to set user's password: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package okta
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccOktaUserPassword_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(userPassword)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
+	oldPasswordUnchanged := mgr.GetFixtures("old_password_unchanged.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", userPassword)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "user_id", "okta_user.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "password", "SimplePassword123"),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "password", "SimplePassword456"),
+					resource.TestCheckResourceAttr(resourceName, "old_password", "SimplePassword123"),
+				),
+			},
+			{
+				Config:      oldPasswordUnchanged,
+				ExpectError: regexp.MustCompile("'old_password' must be changed along with 'password'"),
+			},
+		},
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/okta/okta-sdk-golang/v2/okta"
 )
 
 func TestAccOktaUser_customProfileAttributes(t *testing.T) {
@@ -308,6 +309,55 @@ func TestAccOktaUser_statusDeprovisioned(t *testing.T) {
 	})
 }
 
+// TestAccOktaUser_statusProvisioned the provisioned user which finishes the activation out of band becomes ACTIVE,
+// which should be considered as provisioned
+func TestAccOktaUser_statusProvisioned(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(user)
+	config := mgr.GetFixtures("provisioned.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", user)
+	var userID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status", userStatusProvisioned),
+					func(s *terraform.State) error {
+						userID = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				// setting the password of the provisioned user activates it
+				PreConfig: func() {
+					client := getOktaClientFromMetadata(testAccProvider.Meta())
+					_, _, err := client.User.PartialUpdateUser(context.Background(), userID, okta.User{
+						Credentials: &okta.UserCredentials{
+							Password: &okta.PasswordCredential{Value: "Abcd1234!@#$"},
+						},
+					}, nil)
+					if err != nil {
+						t.Fatalf("failed to activate user: %v", err)
+					}
+				},
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+			},
+		},
+	})
+}
+
 func TestAccOktaUserHashedPassword(t *testing.T) {
 	ri := acctest.RandInt()
 	mgr := newFixtureManager(user)
@@ -440,3 +490,31 @@ resource okta_user "test" {
 }
 `, r)
 }
+
+func TestAccOktaUser_generateTemporaryPassword(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(user)
+	config := mgr.GetFixtures("temporary_password.tf", ri, t)
+	stagedConfig := mgr.GetFixtures("temporary_password_staged.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", user)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      testAccCheckUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      stagedConfig,
+				ExpectError: regexp.MustCompile("'generate_temporary_password' can't be used for the user with the 'STAGED' status"),
+			},
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "temporary_password"),
+					resource.TestCheckResourceAttr(resourceName, "status", statusActive),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,337 @@
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+// usersParallelSearchSize number of users fetched with a single search query on read
+const usersParallelSearchSize = 50
+
+func resourceUsersParallel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUsersParallelCreate,
+		ReadContext:   resourceUsersParallelRead,
+		UpdateContext: resourceUsersParallelUpdate,
+		DeleteContext: resourceUsersParallelDelete,
+		Description: "Resource to manage a large number of users with a minimal set of attributes. Each user is created, " +
+			"read, updated and deleted with its own request, and the requests run in parallel.",
+		Schema: map[string]*schema.Schema{
+			"users": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Users of the resource, `login` identifies the user within the resource",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"login": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "User Okta login",
+						},
+						"email": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "User primary email address",
+						},
+						"first_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "User first name",
+						},
+						"last_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "User last name",
+						},
+						"custom_profile_attributes": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: stringIsJSON,
+							StateFunc:        normalizeDataJSON,
+							Description:      "JSON formatted custom attributes for a user",
+						},
+					},
+				},
+			},
+			"status": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          statusActive,
+				Description:      "Status of the users on creation. Users are created without activation when it is set to STAGED",
+				ValidateDiagFunc: elemInSlice([]string{statusActive, userStatusStaged}),
+				ForceNew:         true,
+			},
+			"user_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of the user logins to the IDs of the users",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceUsersParallelCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	ids := newParallelUserIDs(nil)
+	var funcs []func() error
+	for _, u := range d.Get("users").(*schema.Set).List() {
+		funcs = append(funcs, createParallelUserFunc(ctx, d, m, u.(map[string]interface{}), ids))
+	}
+	errs := runInParallelWithErrors(m, funcs)
+	created := ids.get()
+	if len(created) == 0 && len(errs) > 0 {
+		return parallelUserDiagnostics(diag.Error, errs)
+	}
+	// the resource with the ID set is tainted when the creation fails, which would replace all the users, so the
+	// users which failed to be created are reported as warnings and left out of the state to be created on the
+	// next apply
+	d.SetId(resource.UniqueId())
+	_ = d.Set("user_ids", created)
+	return append(resourceUsersParallelRead(ctx, d, m), parallelUserDiagnostics(diag.Warning, errs)...)
+}
+
+func resourceUsersParallelRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getOktaClientFromMetadata(m)
+	ids := d.Get("user_ids").(map[string]interface{})
+	var (
+		mu    sync.Mutex
+		users = make(map[string]*okta.User, len(ids))
+		funcs []func() error
+	)
+	// the users are fetched one by one, since the search index is eventually consistent and might not contain
+	// the users created right before
+	for login, id := range ids {
+		login, id := login, id.(string)
+		funcs = append(funcs, func() error {
+			user, resp, err := client.User.GetUser(ctx, id)
+			if err := suppressErrorOn404(resp, err); err != nil {
+				return fmt.Errorf("failed to get user '%s': %v", login, err)
+			}
+			if user == nil {
+				return nil
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			users[id] = user
+			return nil
+		})
+	}
+	err := runInParallel(m, "failed to get users", funcs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	actualIDs := make(map[string]interface{})
+	var arr []interface{}
+	for _, raw := range d.Get("users").(*schema.Set).List() {
+		u := raw.(map[string]interface{})
+		login := u["login"].(string)
+		id, ok := ids[login].(string)
+		if !ok {
+			continue
+		}
+		// users removed outside of Terraform are dropped from the state to be created again
+		user, ok := users[id]
+		if !ok {
+			continue
+		}
+		actualIDs[login] = id
+		arr = append(arr, flattenParallelUser(user, u))
+	}
+	_ = d.Set("user_ids", actualIDs)
+	_ = d.Set("users", schema.NewSet(d.Get("users").(*schema.Set).F, arr))
+	return nil
+}
+
+func resourceUsersParallelUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if !d.HasChange("users") {
+		return resourceUsersParallelRead(ctx, d, m)
+	}
+	client := getOktaClientFromMetadata(m)
+	ids := newParallelUserIDs(d.Get("user_ids").(map[string]interface{}))
+	oldUsers, newUsers := d.GetChange("users")
+	oldByLogin := parallelUsersByLogin(oldUsers.(*schema.Set))
+	newByLogin := parallelUsersByLogin(newUsers.(*schema.Set))
+	var funcs []func() error
+	for login := range oldByLogin {
+		if _, ok := newByLogin[login]; ok {
+			continue
+		}
+		id, ok := ids.lookup(login)
+		if !ok {
+			continue
+		}
+		login := login
+		funcs = append(funcs, func() error {
+			err := ensureUserDelete(ctx, id, "", client)
+			if err != nil {
+				return fmt.Errorf("failed to delete user '%s': %v", login, err)
+			}
+			ids.remove(login)
+			return nil
+		})
+	}
+	for login, u := range newByLogin {
+		id, ok := ids.lookup(login)
+		if !ok {
+			funcs = append(funcs, createParallelUserFunc(ctx, d, m, u, ids))
+			continue
+		}
+		if reflect.DeepEqual(oldByLogin[login], u) {
+			continue
+		}
+		login, profile := login, buildParallelUserProfile(u)
+		funcs = append(funcs, func() error {
+			_, _, err := client.User.PartialUpdateUser(ctx, id, okta.User{Profile: profile}, nil)
+			if err != nil {
+				return fmt.Errorf("failed to update user '%s': %v", login, err)
+			}
+			return nil
+		})
+	}
+	err := runInParallel(m, "failed to update users", funcs)
+	_ = d.Set("user_ids", ids.get())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceUsersParallelRead(ctx, d, m)
+}
+
+func resourceUsersParallelDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := getOktaClientFromMetadata(m)
+	var funcs []func() error
+	for login, id := range d.Get("user_ids").(map[string]interface{}) {
+		login, id := login, id.(string)
+		funcs = append(funcs, func() error {
+			err := ensureUserDelete(ctx, id, "", client)
+			if err != nil {
+				return fmt.Errorf("failed to delete user '%s': %v", login, err)
+			}
+			return nil
+		})
+	}
+	return diag.FromErr(runInParallel(m, "failed to delete users", funcs))
+}
+
+func createParallelUserFunc(ctx context.Context, d *schema.ResourceData, m interface{}, u map[string]interface{}, ids *parallelUserIDs) func() error {
+	qp := query.NewQueryParams(query.WithActivate(d.Get("status").(string) == statusActive))
+	body := okta.CreateUserRequest{Profile: buildParallelUserProfile(u)}
+	login := u["login"].(string)
+	return func() error {
+		user, _, err := getOktaClientFromMetadata(m).User.CreateUser(ctx, body, qp)
+		if err != nil {
+			return fmt.Errorf("failed to create user '%s': %v", login, err)
+		}
+		ids.set(login, user.Id)
+		return nil
+	}
+}
+
+func parallelUserDiagnostics(severity diag.Severity, errs []error) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, err := range errs {
+		diags = append(diags, diag.Diagnostic{Severity: severity, Summary: err.Error()})
+	}
+	return diags
+}
+
+func buildParallelUserProfile(u map[string]interface{}) *okta.UserProfile {
+	profile := okta.UserProfile{}
+	if raw, ok := u["custom_profile_attributes"].(string); ok && raw != "" {
+		// We validate the JSON, no need to check error
+		_ = json.Unmarshal([]byte(raw), &profile)
+	}
+	profile["login"] = u["login"].(string)
+	profile["email"] = u["email"].(string)
+	profile["firstName"] = u["first_name"].(string)
+	profile["lastName"] = u["last_name"].(string)
+	return &profile
+}
+
+// flattenParallelUser only the custom attributes present in the configuration are synced, since the
+// profile contains all the attributes defined in the user schema.
+func flattenParallelUser(user *okta.User, configured map[string]interface{}) map[string]interface{} {
+	profile := okta.UserProfile{}
+	if user.Profile != nil {
+		profile = *user.Profile
+	}
+	u := map[string]interface{}{
+		"login":                     configured["login"],
+		"email":                     profile["email"],
+		"first_name":                profile["firstName"],
+		"last_name":                 profile["lastName"],
+		"custom_profile_attributes": configured["custom_profile_attributes"],
+	}
+	raw, _ := configured["custom_profile_attributes"].(string)
+	if raw == "" {
+		return u
+	}
+	var attrs map[string]interface{}
+	_ = json.Unmarshal([]byte(raw), &attrs)
+	for k := range attrs {
+		attrs[k] = profile[k]
+	}
+	data, _ := json.Marshal(attrs)
+	u["custom_profile_attributes"] = string(data)
+	return u
+}
+
+func parallelUsersByLogin(users *schema.Set) map[string]map[string]interface{} {
+	byLogin := make(map[string]map[string]interface{}, users.Len())
+	for _, raw := range users.List() {
+		u := raw.(map[string]interface{})
+		byLogin[u["login"].(string)] = u
+	}
+	return byLogin
+}
+
+// parallelUserIDs map of user logins to user IDs which is safe to be modified concurrently
+type parallelUserIDs struct {
+	sync.Mutex
+	ids map[string]interface{}
+}
+
+func newParallelUserIDs(ids map[string]interface{}) *parallelUserIDs {
+	b := &parallelUserIDs{ids: make(map[string]interface{}, len(ids))}
+	for k, v := range ids {
+		b.ids[k] = v
+	}
+	return b
+}
+
+func (b *parallelUserIDs) lookup(login string) (string, bool) {
+	b.Lock()
+	defer b.Unlock()
+	id, ok := b.ids[login].(string)
+	return id, ok
+}
+
+func (b *parallelUserIDs) set(login, id string) {
+	b.Lock()
+	defer b.Unlock()
+	b.ids[login] = id
+}
+
+func (b *parallelUserIDs) remove(login string) {
+	b.Lock()
+	defer b.Unlock()
+	delete(b.ids, login)
+}
+
+func (b *parallelUserIDs) get() map[string]interface{} {
+	b.Lock()
+	defer b.Unlock()
+	ids := make(map[string]interface{}, len(b.ids))
+	for k, v := range b.ids {
+		ids[k] = v
+	}
+	return ids
+}
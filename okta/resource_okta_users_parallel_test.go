@@ -0,0 +1,69 @@
+package okta
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccOktaUsersParallel_crud(t *testing.T) {
+	ri := acctest.RandInt()
+	mgr := newFixtureManager(usersParallel)
+	config := mgr.GetFixtures("basic.tf", ri, t)
+	updated := mgr.GetFixtures("basic_updated.tf", ri, t)
+	resourceName := fmt.Sprintf("%s.test", usersParallel)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          testAccPreCheck(t),
+		ErrorCheck:        testAccErrorChecks(t),
+		ProviderFactories: testAccProvidersFactories,
+		CheckDestroy:      testAccCheckUsersParallelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "users.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "user_ids.%", "2"),
+				),
+			},
+			{
+				Config: updated,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "users.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "user_ids.%", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "users.*", map[string]string{
+						"login":     fmt.Sprintf("testAcc-1-%d@example.com", ri),
+						"last_name": "Updated",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUsersParallelDestroy(s *terraform.State) error {
+	client := getOktaClientFromMetadata(testAccProvider.Meta())
+	for _, r := range s.RootModule().Resources {
+		if r.Type != usersParallel {
+			continue
+		}
+		for k, id := range r.Primary.Attributes {
+			if !strings.HasPrefix(k, "user_ids.") || k == "user_ids.%" {
+				continue
+			}
+			_, resp, err := client.User.GetUser(context.Background(), id)
+			if err := suppressErrorOn404(resp, err); err != nil {
+				return err
+			}
+			if resp == nil || resp.StatusCode != 404 {
+				return fmt.Errorf("user '%s' still exists", id)
+			}
+		}
+	}
+	return nil
+}
@@ -65,12 +65,14 @@ var themeResourceSchema = map[string]*schema.Schema{
 		Optional:         true,
 		Description:      "Primary color hex code",
 		DiffSuppressFunc: suppressDuringCreateFunc("theme_id"),
+		ValidateDiagFunc: stringIsHexColor,
 	},
 	"primary_color_contrast_hex": {
 		Type:             schema.TypeString,
 		Optional:         true,
 		Description:      "Primary color contrast hex code",
 		DiffSuppressFunc: suppressDuringCreateFunc("theme_id"),
+		ValidateDiagFunc: stringIsHexColor,
 	},
 	"secondary_color_hex": {
 		Type: schema.TypeString,
@@ -78,12 +80,14 @@ var themeResourceSchema = map[string]*schema.Schema{
 		Optional:         true,
 		Description:      "Secondary color hex code",
 		DiffSuppressFunc: suppressDuringCreateFunc("theme_id"),
+		ValidateDiagFunc: stringIsHexColor,
 	},
 	"secondary_color_contrast_hex": {
 		Type:             schema.TypeString,
 		Optional:         true,
 		Description:      "Secondary color contrast hex code",
 		DiffSuppressFunc: suppressDuringCreateFunc("theme_id"),
+		ValidateDiagFunc: stringIsHexColor,
 	},
 	"sign_in_page_touch_point_variant": {
 		Type: schema.TypeString,
@@ -91,6 +95,7 @@ var themeResourceSchema = map[string]*schema.Schema{
 		Optional:         true,
 		Description:      "Variant for the Okta Sign-In Page (`OKTA_DEFAULT`, `BACKGROUND_SECONDARY_COLOR`, `BACKGROUND_IMAGE`)",
 		DiffSuppressFunc: suppressDuringCreateFunc("theme_id"),
+		ValidateDiagFunc: elemInSlice([]string{"OKTA_DEFAULT", "BACKGROUND_SECONDARY_COLOR", "BACKGROUND_IMAGE"}),
 	},
 	"end_user_dashboard_touch_point_variant": {
 		Type: schema.TypeString,
@@ -98,6 +103,7 @@ var themeResourceSchema = map[string]*schema.Schema{
 		Optional:         true,
 		Description:      "Variant for the Okta End-User Dashboard (`OKTA_DEFAULT`, `WHITE_LOGO_BACKGROUND`, `FULL_THEME`, `LOGO_ON_FULL_WHITE_BACKGROUND`)",
 		DiffSuppressFunc: suppressDuringCreateFunc("theme_id"),
+		ValidateDiagFunc: elemInSlice([]string{"OKTA_DEFAULT", "WHITE_LOGO_BACKGROUND", "FULL_THEME", "LOGO_ON_FULL_WHITE_BACKGROUND"}),
 	},
 	"error_page_touch_point_variant": {
 		Type: schema.TypeString,
@@ -105,6 +111,7 @@ var themeResourceSchema = map[string]*schema.Schema{
 		Optional:         true,
 		Description:      "Variant for the error page (`OKTA_DEFAULT`, `BACKGROUND_SECONDARY_COLOR`, `BACKGROUND_IMAGE`)",
 		DiffSuppressFunc: suppressDuringCreateFunc("theme_id"),
+		ValidateDiagFunc: elemInSlice([]string{"OKTA_DEFAULT", "BACKGROUND_SECONDARY_COLOR", "BACKGROUND_IMAGE"}),
 	},
 	"email_template_touch_point_variant": {
 		Type: schema.TypeString,
@@ -112,6 +119,7 @@ var themeResourceSchema = map[string]*schema.Schema{
 		Optional:         true,
 		Description:      "Variant for email templates (`OKTA_DEFAULT`, `FULL_THEME`)",
 		DiffSuppressFunc: suppressDuringCreateFunc("theme_id"),
+		ValidateDiagFunc: elemInSlice([]string{"OKTA_DEFAULT", "FULL_THEME"}),
 	},
 	"links": {
 		Type:        schema.TypeString,
@@ -347,9 +347,20 @@ func setAllGroups(ctx context.Context, d *schema.ResourceData, c *okta.Client) e
 
 // set groups attached to the user that can be changed
 func setGroupUserMemberships(ctx context.Context, d *schema.ResourceData, c *okta.Client) error {
-	groups, response, err := c.User.ListUserGroups(ctx, d.Id())
+	groupIDs, err := listUserChangeableGroupIDs(ctx, c, d.Id())
 	if err != nil {
-		return fmt.Errorf("failed to list user groups: %v", err)
+		return err
+	}
+	return setNonPrimitives(d, map[string]interface{}{
+		"group_memberships": schema.NewSet(schema.HashString, groupIDs),
+	})
+}
+
+// list groups attached to the user that can be changed
+func listUserChangeableGroupIDs(ctx context.Context, c *okta.Client, userID string) ([]interface{}, error) {
+	groups, response, err := c.User.ListUserGroups(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user groups: %v", err)
 	}
 
 	groupIDs := make([]interface{}, 0)
@@ -370,19 +381,32 @@ func setGroupUserMemberships(ctx context.Context, d *schema.ResourceData, c *okt
 		response, err = response.Next(ctx, &groups)
 
 		if err != nil {
-			return fmt.Errorf("failed to list user groups: %v", err)
+			return nil, fmt.Errorf("failed to list user groups: %v", err)
 		}
 	}
 
-	return setNonPrimitives(d, map[string]interface{}{
-		"group_memberships": schema.NewSet(schema.HashString, groupIDs),
-	})
+	return groupIDs, nil
 }
 
 func isCustomUserAttr(key string) bool {
 	return !contains(profileKeys, key)
 }
 
+// Removes nulls from user custom attributes, since Okta does not render nulls in profile
+func normalizeUserCustomAttributes(attrs map[string]interface{}) map[string]interface{} {
+	trimmed := make(map[string]interface{})
+	for k, v := range attrs {
+		if v != nil {
+			trimmed[k] = v
+		}
+	}
+	return trimmed
+}
+
+func isUserSecretOrCustomKey(key string) bool {
+	return contains([]string{"custom_profile_attributes", "password", "recovery_question", "recovery_answer"}, key)
+}
+
 func flattenUser(u *okta.User) map[string]interface{} {
 	customAttributes := make(map[string]interface{})
 	attrs := map[string]interface{}{}
@@ -416,6 +440,13 @@ func flattenUser(u *okta.User) map[string]interface{} {
 		}
 	}
 
+	// standard attributes removed from the profile in Okta are cleared, so the drift gets detected
+	for _, k := range profileKeys {
+		if _, ok := attrs[k]; !ok && !isUserSecretOrCustomKey(k) {
+			attrs[k] = ""
+		}
+	}
+
 	attrs["status"] = mapStatus(u.Status)
 
 	data, _ := json.Marshal(customAttributes)
@@ -438,6 +469,16 @@ func updateUserStatus(ctx context.Context, uid, desiredStatus string, c *okta.Cl
 		_, statusErr = c.User.SuspendUser(ctx, uid)
 	case userStatusDeprovisioned:
 		_, statusErr = c.User.DeactivateUser(ctx, uid, nil)
+	case userStatusProvisioned:
+		switch user.Status {
+		case userStatusProvisioned, statusActive, userStatusPasswordExpired:
+			// the user is provisioned already, or it has finished the activation since
+			return nil
+		case userStatusStaged, userStatusDeprovisioned:
+			_, _, statusErr = c.User.ActivateUser(ctx, uid, query.NewQueryParams(query.WithSendEmail(false)))
+		default:
+			return fmt.Errorf("user in the %s status can not be set to %s", user.Status, userStatusProvisioned)
+		}
 	case statusActive:
 		switch user.Status {
 		case userStatusSuspended:
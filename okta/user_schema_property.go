@@ -153,6 +153,27 @@ var (
 		},
 	}
 
+	userMasterOverridePrioritySchema = map[string]*schema.Schema{
+		"master_override_priority": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Prioritized list of profile sources, required when 'master' is set to 'OVERRIDE'",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "APP",
+					},
+					"value": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+	}
+
 	userPatternSchema = map[string]*schema.Schema{
 		"pattern": {
 			Type:        schema.TypeString,
@@ -188,9 +209,7 @@ func syncCustomUserSchema(d *schema.ResourceData, subschema *okta.UserSchemaAttr
 	stringifyOneOfSlice(subschema.Type, &subschema.OneOf)
 	stringifyEnumSlice(subschema.Type, &subschema.Enum)
 
-	if len(subschema.Enum) > 0 {
-		_ = d.Set("enum", subschema.Enum)
-	}
+	_ = d.Set("enum", subschema.Enum)
 
 	return setNonPrimitives(d, map[string]interface{}{
 		"one_of": flattenOneOf(subschema.OneOf),
@@ -212,13 +231,15 @@ func syncBaseUserSchema(d *schema.ResourceData, subschema *okta.UserSchemaAttrib
 				}
 			}
 			_ = setNonPrimitives(d, map[string]interface{}{"master_override_priority": arr})
+		} else {
+			_ = setNonPrimitives(d, map[string]interface{}{"master_override_priority": []map[string]interface{}{}})
 		}
 	}
 	if len(subschema.Permissions) > 0 {
 		_ = d.Set("permissions", subschema.Permissions[0].Action)
 	}
 	if subschema.Pattern != nil {
-		_ = d.Set("pattern", &subschema.Pattern)
+		_ = d.Set("pattern", *subschema.Pattern)
 	}
 }
 
@@ -334,6 +355,9 @@ func buildUserCustomSchemaAttribute(d *schema.ResourceData) (*okta.UserSchemaAtt
 	if rawEnum, ok := d.GetOk("enum"); ok {
 		attribute.Enum = rawEnum.([]interface{})
 	}
+	if p, ok := d.GetOk("pattern"); ok {
+		attribute.Pattern = stringPtr(p.(string))
+	}
 	return attribute, nil
 }
 
@@ -103,3 +103,76 @@ func TestUserSetAllGroups(t *testing.T) {
 func TestUserSetGroups(t *testing.T) {
 	testUserGroupFetchesAllPages(t, setGroupUserMemberships)
 }
+
+func TestFlattenUserClearsRemovedAttributes(t *testing.T) {
+	profile := okta.UserProfile{
+		"login":     "john.doe@example.com",
+		"email":     "john.doe@example.com",
+		"firstName": "John",
+		"lastName":  "Doe",
+	}
+	attrs := flattenUser(&okta.User{Profile: &profile, Status: statusActive})
+	if attrs["title"] != "" {
+		t.Errorf("expected title to be cleared, got %v", attrs["title"])
+	}
+	if attrs["first_name"] != "John" {
+		t.Errorf("expected first_name to be John, got %v", attrs["first_name"])
+	}
+	if _, ok := attrs["password"]; ok {
+		t.Error("password should not be set from the profile")
+	}
+}
+
+func TestNormalizeUserCustomAttributes(t *testing.T) {
+	attrs := normalizeUserCustomAttributes(map[string]interface{}{
+		"costCode": "abc",
+		"nickname": nil,
+	})
+	if len(attrs) != 1 || attrs["costCode"] != "abc" {
+		t.Errorf("expected only non null attributes, got %v", attrs)
+	}
+}
+
+func TestValidatePasswordHash(t *testing.T) {
+	tests := []struct {
+		hash    map[string]interface{}
+		wantErr bool
+	}{
+		{map[string]interface{}{"algorithm": "BCRYPT", "value": "abc", "work_factor": 10, "salt": "rwh3vH166HCH/NT9XV5FYu"}, false},
+		{map[string]interface{}{"algorithm": "BCRYPT", "value": "abc", "salt": "rwh3vH166HCH/NT9XV5FYu"}, true},
+		{map[string]interface{}{"algorithm": "BCRYPT", "value": "abc", "work_factor": 10}, true},
+		{map[string]interface{}{"algorithm": "SHA-256", "value": "abc"}, false},
+		{map[string]interface{}{"algorithm": "SHA-256", "value": "abc", "salt": "c2FsdA=="}, true},
+		{map[string]interface{}{"algorithm": "SHA-256", "value": "abc", "salt": "c2FsdA==", "salt_order": "PREFIX"}, false},
+	}
+	for i, test := range tests {
+		d := schema.TestResourceDataRaw(t, resourceUser().Schema, map[string]interface{}{
+			"password_hash": []interface{}{test.hash},
+		})
+		err := validatePasswordHash(d.Get("password_hash"))
+		if (err != nil) != test.wantErr {
+			t.Errorf("case %d: expected error %v, got %v", i, test.wantErr, err)
+		}
+	}
+}
+
+func TestValidateTemporaryPasswordGeneration(t *testing.T) {
+	tests := []struct {
+		status      string
+		hasPassword bool
+		wantErr     bool
+	}{
+		{statusActive, true, false},
+		{statusActive, false, true},
+		{userStatusStaged, true, true},
+		{userStatusProvisioned, true, true},
+		{userStatusSuspended, true, true},
+		{userStatusDeprovisioned, true, true},
+	}
+	for i, test := range tests {
+		err := validateTemporaryPasswordGeneration(test.status, test.hasPassword)
+		if (err != nil) != test.wantErr {
+			t.Errorf("case %d: expected error %v, got %v", i, test.wantErr, err)
+		}
+	}
+}
@@ -1,10 +1,13 @@
 package okta
 
 import (
+	"errors"
+	"fmt"
 	"net/url"
 	"os"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -171,6 +174,10 @@ var (
 	emailRegex   = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$")
 	versionRegex = regexp.MustCompile(`^(\d+)\.(\d+)\.(\d+)$`)
 	periodRegex  = regexp.MustCompile(`^P(([0-9]+Y)?([0-9]+M)?([0-9]+W)?([0-9]+D)?(T([0-9]+H)?([0-9]+M)?([0-9]+(\.?[0-9]+)?S)?))?$`)
+	// ISO-3166-1 country code optionally followed by ISO-3166-2 region code, e.g. "US" or "US-CA"
+	locationRegex = regexp.MustCompile(`^[A-Z]{2}(-[A-Z0-9]{1,3})?$`)
+	asnRegex      = regexp.MustCompile(`^[0-9]+$`)
+	hexColorRegex = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
 )
 
 func stringMatches(i interface{}, k cty.Path, r *regexp.Regexp, name string) diag.Diagnostics {
@@ -198,3 +205,89 @@ func stringIsVersion(i interface{}, k cty.Path) diag.Diagnostics {
 func stringIsPeriod(i interface{}, k cty.Path) diag.Diagnostics {
 	return stringMatches(i, k, periodRegex, "period")
 }
+
+func stringIsLocation(i interface{}, k cty.Path) diag.Diagnostics {
+	return stringMatches(i, k, locationRegex, "location (countryCode or countryCode-regionCode)")
+}
+
+func stringIsASN(i interface{}, k cty.Path) diag.Diagnostics {
+	return stringMatches(i, k, asnRegex, "autonomous system number")
+}
+
+func stringIsHexColor(i interface{}, k cty.Path) diag.Diagnostics {
+	return stringMatches(i, k, hexColorRegex, "hex color code (e.g. #1662dd)")
+}
+
+func stringIsRFC3339Time(i interface{}, k cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of %s to be string", k)
+	}
+	if _, err := time.Parse(time.RFC3339, v); err != nil {
+		return diag.Errorf("expected %s to be a valid RFC3339 date (e.g. 2022-10-01T00:00:00Z), got %s", k, v)
+	}
+	return nil
+}
+
+// stringIsOktaExpression performs a lexical check of the Okta Expression Language, so obviously invalid
+// expressions (e.g. unbalanced brackets or unterminated strings) fail at plan time. The expression itself
+// is evaluated by Okta.
+func stringIsOktaExpression(i interface{}, k cty.Path) diag.Diagnostics {
+	v, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected type of %s to be string", k)
+	}
+	if err := validateOktaExpression(v); err != nil {
+		return diag.Errorf("invalid Okta expression '%s': %v", v, err)
+	}
+	return nil
+}
+
+func validateOktaExpression(expr string) error {
+	if strings.TrimSpace(expr) == "" {
+		return errors.New("expression should not be empty")
+	}
+	pairs := map[rune]rune{')': '(', ']': '[', '}': '{'}
+	var stack []rune
+	var quote rune
+	runes := []rune(expr)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if quote != 0 {
+			switch r {
+			case '\\':
+				i++
+			case quote:
+				quote = 0
+			}
+			continue
+		}
+		switch r {
+		case '"', '\'':
+			quote = r
+		case '(', '[', '{':
+			stack = append(stack, r)
+		case ')', ']', '}':
+			if len(stack) == 0 || stack[len(stack)-1] != pairs[r] {
+				return fmt.Errorf("unexpected '%c' at position %d", r, i+1)
+			}
+			stack = stack[:len(stack)-1]
+		case '=':
+			// assignment is not supported, only '==', '!=', '<=' and '>=' comparisons
+			prevOperator := i > 0 && strings.ContainsRune("=!<>", runes[i-1])
+			nextEquals := i+1 < len(runes) && runes[i+1] == '='
+			if nextEquals {
+				i++
+			} else if !prevOperator {
+				return fmt.Errorf("unexpected '=' at position %d, use '==' for comparison", i+1)
+			}
+		}
+	}
+	if quote != 0 {
+		return fmt.Errorf("unterminated string literal, missing closing %c", quote)
+	}
+	if len(stack) != 0 {
+		return fmt.Errorf("unclosed '%c'", stack[len(stack)-1])
+	}
+	return nil
+}
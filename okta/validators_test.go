@@ -0,0 +1,106 @@
+package okta
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestValidateOktaExpression(t *testing.T) {
+	tests := []struct {
+		expr  string
+		valid bool
+	}{
+		{`String.startsWith(user.firstName,"andy")`, true},
+		{`user.department == "Engineering" AND user.title != "Intern"`, true},
+		{`isMemberOfAnyGroup("00g1", "00g2") ? user.level >= 2 : false`, true},
+		{`String.stringContains(user.email, "(test)")`, true},
+		{`user.login == 'o\'brien@example.com'`, true},
+		{``, false},
+		{`String.startsWith(user.firstName,"andy"`, false},
+		{`String.startsWith(user.firstName,"andy))`, false},
+		{`user.department = "Engineering"`, false},
+		{`Arrays.contains(user.groups, "a"))`, false},
+		{`Arrays.contains([user.groups, "a")]`, false},
+	}
+	for _, test := range tests {
+		err := validateOktaExpression(test.expr)
+		if test.valid && err != nil {
+			t.Errorf("expected %q to be valid, got: %v", test.expr, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("expected %q to be invalid", test.expr)
+		}
+	}
+}
+
+func TestStringIsLocation(t *testing.T) {
+	tests := []struct {
+		location string
+		valid    bool
+	}{
+		{"US", true},
+		{"US-CA", true},
+		{"AF-BGL", true},
+		{"UA-26", true},
+		{"us", false},
+		{"USA", false},
+		{"US-", false},
+		{"US-CA-1", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		diags := stringIsLocation(test.location, cty.Path{})
+		if test.valid && diags.HasError() {
+			t.Errorf("expected %q to be valid, got: %v", test.location, diags)
+		}
+		if !test.valid && !diags.HasError() {
+			t.Errorf("expected %q to be invalid", test.location)
+		}
+	}
+}
+
+func TestStringIsHexColor(t *testing.T) {
+	tests := []struct {
+		color string
+		valid bool
+	}{
+		{"#1662dd", true},
+		{"#FFFFFF", true},
+		{"1662dd", false},
+		{"#fff", false},
+		{"#1662dg", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		diags := stringIsHexColor(test.color, cty.Path{})
+		if test.valid && diags.HasError() {
+			t.Errorf("expected %q to be valid, got: %v", test.color, diags)
+		}
+		if !test.valid && !diags.HasError() {
+			t.Errorf("expected %q to be invalid", test.color)
+		}
+	}
+}
+
+func TestStringIsRFC3339Time(t *testing.T) {
+	tests := []struct {
+		date  string
+		valid bool
+	}{
+		{"2022-10-01T00:00:00Z", true},
+		{"2022-10-01T00:00:00.000+02:00", true},
+		{"2022-10-01", false},
+		{"01/10/2022", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		diags := stringIsRFC3339Time(test.date, cty.Path{})
+		if test.valid && diags.HasError() {
+			t.Errorf("expected %q to be valid, got: %v", test.date, diags)
+		}
+		if !test.valid && !diags.HasError() {
+			t.Errorf("expected %q to be invalid", test.date)
+		}
+	}
+}
@@ -8,14 +8,54 @@ import (
 	"github.com/okta/okta-sdk-golang/v2/okta"
 )
 
+// AccessPolicyRule okta.AccessPolicyRule with the app sign-on actions which support all the
+// authenticator constraints, e.g. the user verification of the possession factors.
+type AccessPolicyRule struct {
+	okta.AccessPolicyRule
+	Actions *AccessPolicyRuleActions `json:"actions,omitempty"`
+}
+
+type AccessPolicyRuleActions struct {
+	AppSignOn *AccessPolicyRuleApplicationSignOn `json:"appSignOn,omitempty"`
+}
+
+type AccessPolicyRuleApplicationSignOn struct {
+	Access             string              `json:"access,omitempty"`
+	VerificationMethod *VerificationMethod `json:"verificationMethod,omitempty"`
+}
+
+type VerificationMethod struct {
+	Constraints      []*AccessPolicyConstraints `json:"constraints,omitempty"`
+	FactorMode       string                     `json:"factorMode,omitempty"`
+	InactivityPeriod string                     `json:"inactivityPeriod,omitempty"`
+	ReauthenticateIn string                     `json:"reauthenticateIn,omitempty"`
+	Type             string                     `json:"type,omitempty"`
+}
+
+type AccessPolicyConstraints struct {
+	Knowledge  *okta.KnowledgeConstraint `json:"knowledge,omitempty"`
+	Possession *PossessionConstraint     `json:"possession,omitempty"`
+}
+
+type PossessionConstraint struct {
+	Methods            []string `json:"methods,omitempty"`
+	ReauthenticateIn   string   `json:"reauthenticateIn,omitempty"`
+	Types              []string `json:"types,omitempty"`
+	DeviceBound        string   `json:"deviceBound,omitempty"`
+	HardwareProtection string   `json:"hardwareProtection,omitempty"`
+	PhishingResistant  string   `json:"phishingResistant,omitempty"`
+	UserPresence       string   `json:"userPresence,omitempty"`
+	UserVerification   string   `json:"userVerification,omitempty"`
+}
+
 // CreateAppSignOnPolicyRule creates a policy rule.
-func (m *APISupplement) CreateAppSignOnPolicyRule(ctx context.Context, policyID string, body okta.AccessPolicyRule) (*okta.AccessPolicyRule, *okta.Response, error) {
+func (m *APISupplement) CreateAppSignOnPolicyRule(ctx context.Context, policyID string, body AccessPolicyRule) (*AccessPolicyRule, *okta.Response, error) {
 	url := fmt.Sprintf("/api/v1/policies/%v/rules", policyID)
 	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
 	if err != nil {
 		return nil, nil, err
 	}
-	var appSignOnPolicyRule *okta.AccessPolicyRule
+	var appSignOnPolicyRule *AccessPolicyRule
 	resp, err := m.RequestExecutor.Do(ctx, req, &appSignOnPolicyRule)
 	if err != nil {
 		return nil, resp, err
@@ -24,13 +64,13 @@ func (m *APISupplement) CreateAppSignOnPolicyRule(ctx context.Context, policyID
 }
 
 // GetAppSignOnPolicyRule gets a policy rule.
-func (m *APISupplement) GetAppSignOnPolicyRule(ctx context.Context, policyID, ruleId string) (*okta.AccessPolicyRule, *okta.Response, error) {
+func (m *APISupplement) GetAppSignOnPolicyRule(ctx context.Context, policyID, ruleId string) (*AccessPolicyRule, *okta.Response, error) {
 	url := fmt.Sprintf("/api/v1/policies/%v/rules/%v", policyID, ruleId)
 	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodGet, url, nil)
 	if err != nil {
 		return nil, nil, err
 	}
-	var appSignOnPolicyRule *okta.AccessPolicyRule
+	var appSignOnPolicyRule *AccessPolicyRule
 	resp, err := m.RequestExecutor.Do(ctx, req, &appSignOnPolicyRule)
 	if err != nil {
 		return nil, resp, err
@@ -39,13 +79,13 @@ func (m *APISupplement) GetAppSignOnPolicyRule(ctx context.Context, policyID, ru
 }
 
 // UpdateAppSignOnPolicyRule updates a policy rule.
-func (m *APISupplement) UpdateAppSignOnPolicyRule(ctx context.Context, policyID, ruleId string, body okta.AccessPolicyRule) (*okta.AccessPolicyRule, *okta.Response, error) {
+func (m *APISupplement) UpdateAppSignOnPolicyRule(ctx context.Context, policyID, ruleId string, body AccessPolicyRule) (*AccessPolicyRule, *okta.Response, error) {
 	url := fmt.Sprintf("/api/v1/policies/%v/rules/%v", policyID, ruleId)
 	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
 	if err != nil {
 		return nil, nil, err
 	}
-	var appSignOnPolicyRule *okta.AccessPolicyRule
+	var appSignOnPolicyRule *AccessPolicyRule
 	resp, err := m.RequestExecutor.Do(ctx, req, &appSignOnPolicyRule)
 	if err != nil {
 		return nil, resp, err
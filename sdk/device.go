@@ -0,0 +1,139 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+const (
+	DeviceStatusActive      = "ACTIVE"
+	DeviceStatusCreated     = "CREATED"
+	DeviceStatusDeactivated = "DEACTIVATED"
+	DeviceStatusSuspended   = "SUSPENDED"
+)
+
+type Device struct {
+	Id           string          `json:"id,omitempty"`
+	Status       string          `json:"status,omitempty"`
+	ResourceType string          `json:"resourceType,omitempty"`
+	Created      *time.Time      `json:"created,omitempty"`
+	LastUpdated  *time.Time      `json:"lastUpdated,omitempty"`
+	Profile      *DeviceProfile  `json:"profile,omitempty"`
+	Embedded     *DeviceEmbedded `json:"_embedded,omitempty"`
+}
+
+type DeviceProfile struct {
+	DisplayName  string `json:"displayName,omitempty"`
+	Platform     string `json:"platform,omitempty"`
+	Manufacturer string `json:"manufacturer,omitempty"`
+	Model        string `json:"model,omitempty"`
+	OsVersion    string `json:"osVersion,omitempty"`
+	SerialNumber string `json:"serialNumber,omitempty"`
+	Registered   *bool  `json:"registered,omitempty"`
+}
+
+// DeviceEmbedded users are embedded only when the devices are listed with the 'user' expand
+type DeviceEmbedded struct {
+	Users []*DeviceUser `json:"users,omitempty"`
+}
+
+type DeviceUser struct {
+	Created          *time.Time `json:"created,omitempty"`
+	ManagementStatus string     `json:"managementStatus,omitempty"`
+	ScreenLockType   string     `json:"screenLockType,omitempty"`
+	User             *okta.User `json:"user,omitempty"`
+}
+
+// UserDevice device of the user, as it's returned by the user devices API
+type UserDevice struct {
+	Created *time.Time `json:"created,omitempty"`
+	Device  *Device    `json:"device,omitempty"`
+}
+
+// ListDevices lists the devices, they can be searched by their status and profile properties
+func (m *APISupplement) ListDevices(ctx context.Context, qp *query.Params) ([]*Device, *okta.Response, error) {
+	url := "/api/v1/devices"
+	if qp != nil {
+		url += qp.String()
+	}
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var devices []*Device
+	resp, err := m.RequestExecutor.Do(ctx, req, &devices)
+	if err != nil {
+		return nil, resp, err
+	}
+	return devices, resp, nil
+}
+
+// ListUserDevices lists the devices of the user
+func (m *APISupplement) ListUserDevices(ctx context.Context, userID string) ([]*UserDevice, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/users/%s/devices", userID)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var devices []*UserDevice
+	resp, err := m.RequestExecutor.Do(ctx, req, &devices)
+	if err != nil {
+		return nil, resp, err
+	}
+	return devices, resp, nil
+}
+
+// GetDevice gets device by ID
+func (m *APISupplement) GetDevice(ctx context.Context, id string) (*Device, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/devices/%s", id)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var device *Device
+	resp, err := m.RequestExecutor.Do(ctx, req, &device)
+	if err != nil {
+		return nil, resp, err
+	}
+	return device, resp, nil
+}
+
+// DeleteDevice deletes device, it should be deactivated first
+func (m *APISupplement) DeleteDevice(ctx context.Context, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/devices/%s", id)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+func (m *APISupplement) ActivateDevice(ctx context.Context, id string) (*okta.Response, error) {
+	return m.changeDeviceLifecycle(ctx, id, "activate")
+}
+
+func (m *APISupplement) DeactivateDevice(ctx context.Context, id string) (*okta.Response, error) {
+	return m.changeDeviceLifecycle(ctx, id, "deactivate")
+}
+
+func (m *APISupplement) SuspendDevice(ctx context.Context, id string) (*okta.Response, error) {
+	return m.changeDeviceLifecycle(ctx, id, "suspend")
+}
+
+func (m *APISupplement) UnsuspendDevice(ctx context.Context, id string) (*okta.Response, error) {
+	return m.changeDeviceLifecycle(ctx, id, "unsuspend")
+}
+
+func (m *APISupplement) changeDeviceLifecycle(ctx context.Context, id, action string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/devices/%s/lifecycle/%s", id, action)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
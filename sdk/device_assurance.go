@@ -0,0 +1,135 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+const (
+	DeviceAssurancePlatformAndroid  = "ANDROID"
+	DeviceAssurancePlatformChromeOS = "CHROMEOS"
+	DeviceAssurancePlatformIOS      = "IOS"
+	DeviceAssurancePlatformMacOS    = "MACOS"
+	DeviceAssurancePlatformWindows  = "WINDOWS"
+)
+
+type DeviceAssurance struct {
+	Id                        string                                    `json:"id,omitempty"`
+	Name                      string                                    `json:"name,omitempty"`
+	Platform                  string                                    `json:"platform,omitempty"`
+	OsVersion                 *DeviceAssuranceVersion                   `json:"osVersion,omitempty"`
+	DiskEncryptionType        *DeviceAssuranceIncludeCondition          `json:"diskEncryptionType,omitempty"`
+	Jailbreak                 *bool                                     `json:"jailbreak,omitempty"`
+	ScreenLockType            *DeviceAssuranceIncludeCondition          `json:"screenLockType,omitempty"`
+	SecureHardwarePresent     *bool                                     `json:"secureHardwarePresent,omitempty"`
+	ThirdPartySignalProviders *DeviceAssuranceThirdPartySignalProviders `json:"thirdPartySignalProviders,omitempty"`
+	DevicePostureChecks       *DeviceAssurancePostureChecks             `json:"devicePostureChecks,omitempty"`
+	CreatedBy                 string                                    `json:"createdBy,omitempty"`
+	CreatedDate               string                                    `json:"createdDate,omitempty"`
+	LastUpdate                string                                    `json:"lastUpdate,omitempty"`
+	LastUpdatedBy             string                                    `json:"lastUpdatedBy,omitempty"`
+}
+
+type DeviceAssuranceThirdPartySignalProviders struct {
+	Dtc *DeviceAssuranceDTC `json:"dtc,omitempty"`
+}
+
+// DeviceAssuranceDTC signals collected by Chrome Device Trust
+type DeviceAssuranceDTC struct {
+	AllowScreenLock                  *bool                   `json:"allowScreenLock,omitempty"`
+	BrowserVersion                   *DeviceAssuranceVersion `json:"browserVersion,omitempty"`
+	BuiltInDnsClientEnabled          *bool                   `json:"builtInDnsClientEnabled,omitempty"`
+	ChromeRemoteDesktopAppBlocked    *bool                   `json:"chromeRemoteDesktopAppBlocked,omitempty"`
+	CrowdStrikeAgentId               string                  `json:"crowdStrikeAgentId,omitempty"`
+	CrowdStrikeCustomerId            string                  `json:"crowdStrikeCustomerId,omitempty"`
+	DeviceEnrollmentDomain           string                  `json:"deviceEnrollmentDomain,omitempty"`
+	DiskEncrypted                    *bool                   `json:"diskEncrypted,omitempty"`
+	KeyTrustLevel                    string                  `json:"keyTrustLevel,omitempty"`
+	OsFirewall                       *bool                   `json:"osFirewall,omitempty"`
+	OsVersion                        *DeviceAssuranceVersion `json:"osVersion,omitempty"`
+	PasswordProtectionWarningTrigger string                  `json:"passwordProtectionWarningTrigger,omitempty"`
+	RealtimeUrlCheckMode             *bool                   `json:"realtimeUrlCheckMode,omitempty"`
+	SafeBrowsingProtectionLevel      string                  `json:"safeBrowsingProtectionLevel,omitempty"`
+	ScreenLockSecured                *bool                   `json:"screenLockSecured,omitempty"`
+	SecureBootEnabled                *bool                   `json:"secureBootEnabled,omitempty"`
+	SiteIsolationEnabled             *bool                   `json:"siteIsolationEnabled,omitempty"`
+	ThirdPartyBlockingEnabled        *bool                   `json:"thirdPartyBlockingEnabled,omitempty"`
+	WindowsMachineDomain             string                  `json:"windowsMachineDomain,omitempty"`
+	WindowsUserDomain                string                  `json:"windowsUserDomain,omitempty"`
+}
+
+// DeviceAssurancePostureChecks custom device posture checks, which are the osquery queries run by Okta Verify on the
+// desktop platforms, referenced by their variable names
+type DeviceAssurancePostureChecks struct {
+	Include []DeviceAssurancePostureCheck `json:"include,omitempty"`
+}
+
+type DeviceAssurancePostureCheck struct {
+	VariableName string `json:"variableName,omitempty"`
+}
+
+type DeviceAssuranceVersion struct {
+	Minimum string `json:"minimum,omitempty"`
+}
+
+type DeviceAssuranceIncludeCondition struct {
+	Include []string `json:"include,omitempty"`
+}
+
+// GetDeviceAssurance gets device assurance policy by ID
+func (m *APISupplement) GetDeviceAssurance(ctx context.Context, id string) (*DeviceAssurance, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/device-assurances/%s", id)
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var deviceAssurance *DeviceAssurance
+	resp, err := m.RequestExecutor.Do(ctx, req, &deviceAssurance)
+	if err != nil {
+		return nil, resp, err
+	}
+	return deviceAssurance, resp, nil
+}
+
+// CreateDeviceAssurance creates device assurance policy
+func (m *APISupplement) CreateDeviceAssurance(ctx context.Context, body DeviceAssurance) (*DeviceAssurance, *okta.Response, error) {
+	url := "/api/v1/device-assurances"
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var deviceAssurance *DeviceAssurance
+	resp, err := m.RequestExecutor.Do(ctx, req, &deviceAssurance)
+	if err != nil {
+		return nil, resp, err
+	}
+	return deviceAssurance, resp, nil
+}
+
+// UpdateDeviceAssurance replaces device assurance policy
+func (m *APISupplement) UpdateDeviceAssurance(ctx context.Context, id string, body DeviceAssurance) (*DeviceAssurance, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/device-assurances/%s", id)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var deviceAssurance *DeviceAssurance
+	resp, err := m.RequestExecutor.Do(ctx, req, &deviceAssurance)
+	if err != nil {
+		return nil, resp, err
+	}
+	return deviceAssurance, resp, nil
+}
+
+// DeleteDeviceAssurance deletes device assurance policy by ID
+func (m *APISupplement) DeleteDeviceAssurance(ctx context.Context, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/device-assurances/%s", id)
+	req, err := m.RequestExecutor.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
@@ -0,0 +1,97 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+type (
+	EmailDomain struct {
+		ID                   string                           `json:"id,omitempty"`                   // computed
+		BrandID              string                           `json:"brandId,omitempty"`              // recreate
+		Domain               string                           `json:"domain,omitempty"`               // recreate
+		DisplayName          string                           `json:"displayName,omitempty"`          // updatable
+		UserName             string                           `json:"userName,omitempty"`             // updatable
+		ValidationStatus     string                           `json:"validationStatus,omitempty"`     // computed
+		DNSValidationRecords []EmailDomainDNSValidationRecord `json:"dnsValidationRecords,omitempty"` // computed
+	}
+	EmailDomainDNSValidationRecord struct {
+		RecordType        string `json:"recordType,omitempty"`
+		Fqdn              string `json:"fqdn,omitempty"`
+		VerificationValue string `json:"verificationValue,omitempty"`
+		Expiration        string `json:"expiration,omitempty"`
+	}
+	// EmailDomainUpdate only the sender's name and address can be changed, brand and domain are immutable
+	EmailDomainUpdate struct {
+		DisplayName string `json:"displayName"`
+		UserName    string `json:"userName"`
+	}
+)
+
+func (m *APISupplement) CreateEmailDomain(ctx context.Context, body EmailDomain) (*EmailDomain, *okta.Response, error) {
+	url := "/api/v1/email-domains"
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var domain *EmailDomain
+	resp, err := m.RequestExecutor.Do(ctx, req, &domain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return domain, resp, nil
+}
+
+func (m *APISupplement) GetEmailDomain(ctx context.Context, id string) (*EmailDomain, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/email-domains/%s", id)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var domain *EmailDomain
+	resp, err := m.RequestExecutor.Do(ctx, req, &domain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return domain, resp, nil
+}
+
+func (m *APISupplement) UpdateEmailDomain(ctx context.Context, id string, body EmailDomainUpdate) (*EmailDomain, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/email-domains/%s", id)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var domain *EmailDomain
+	resp, err := m.RequestExecutor.Do(ctx, req, &domain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return domain, resp, nil
+}
+
+func (m *APISupplement) DeleteEmailDomain(ctx context.Context, id string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/email-domains/%s", id)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
+
+func (m *APISupplement) VerifyEmailDomain(ctx context.Context, id string) (*EmailDomain, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/email-domains/%s/verify", id)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var domain *EmailDomain
+	resp, err := m.RequestExecutor.Do(ctx, req, &domain)
+	if err != nil {
+		return nil, resp, err
+	}
+	return domain, resp, nil
+}
@@ -0,0 +1,95 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// EventHook okta.EventHook with the events which support the delivery filters, and the channel which supports
+// the OAuth 2.0 client credentials authentication
+type EventHook struct {
+	okta.EventHook
+	Channel *EventHookChannel `json:"channel,omitempty"`
+	Events  *EventHookEvents  `json:"events,omitempty"`
+}
+
+type EventHookChannel struct {
+	Config  *EventHookChannelConfig `json:"config,omitempty"`
+	Type    string                  `json:"type,omitempty"`
+	Version string                  `json:"version,omitempty"`
+}
+
+// EventHookChannelConfig the OAuth properties are only used by the channels of the OAUTH type
+type EventHookChannelConfig struct {
+	okta.EventHookChannelConfig
+	HookChannelOAuthConfig
+}
+
+type EventHookEvents struct {
+	Items  []string         `json:"items,omitempty"`
+	Type   string           `json:"type,omitempty"`
+	Filter *EventHookFilter `json:"filter,omitempty"`
+}
+
+type EventHookFilter struct {
+	Type           string                  `json:"type,omitempty"`
+	EventFilterMap []*EventHookFilterEvent `json:"eventFilterMap,omitempty"`
+}
+
+type EventHookFilterEvent struct {
+	Event     string                    `json:"event,omitempty"`
+	Condition *EventHookFilterCondition `json:"condition,omitempty"`
+}
+
+type EventHookFilterCondition struct {
+	Expression string `json:"expression,omitempty"`
+	Version    string `json:"version,omitempty"`
+}
+
+// GetEventHook gets event hook by ID
+func (m *APISupplement) GetEventHook(ctx context.Context, id string) (*EventHook, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/eventHooks/%s", id)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var eventHook *EventHook
+	resp, err := m.RequestExecutor.Do(ctx, req, &eventHook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return eventHook, resp, nil
+}
+
+// CreateEventHook creates event hook
+func (m *APISupplement) CreateEventHook(ctx context.Context, body EventHook) (*EventHook, *okta.Response, error) {
+	url := "/api/v1/eventHooks"
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var eventHook *EventHook
+	resp, err := m.RequestExecutor.Do(ctx, req, &eventHook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return eventHook, resp, nil
+}
+
+// UpdateEventHook replaces event hook
+func (m *APISupplement) UpdateEventHook(ctx context.Context, id string, body EventHook) (*EventHook, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/eventHooks/%s", id)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var eventHook *EventHook
+	resp, err := m.RequestExecutor.Do(ctx, req, &eventHook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return eventHook, resp, nil
+}
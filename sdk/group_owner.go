@@ -0,0 +1,64 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+type GroupOwner struct {
+	ID          string     `json:"id,omitempty"`
+	Type        string     `json:"type,omitempty"`
+	DisplayName string     `json:"displayName,omitempty"`
+	OriginID    string     `json:"originId,omitempty"`
+	OriginType  string     `json:"originType,omitempty"`
+	Resolved    *bool      `json:"resolved,omitempty"`
+	LastUpdated *time.Time `json:"lastUpdated,omitempty"`
+}
+
+// ListGroupOwners lists all owners of the group
+func (m *APISupplement) ListGroupOwners(ctx context.Context, groupID string, qp *query.Params) ([]*GroupOwner, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/groups/%s/owners", groupID)
+	if qp != nil {
+		url += qp.String()
+	}
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var owners []*GroupOwner
+	resp, err := m.RequestExecutor.Do(ctx, req, &owners)
+	if err != nil {
+		return nil, resp, err
+	}
+	return owners, resp, nil
+}
+
+// AssignGroupOwner assigns a user or a group as an owner of the group
+func (m *APISupplement) AssignGroupOwner(ctx context.Context, groupID string, body GroupOwner) (*GroupOwner, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/groups/%s/owners", groupID)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var owner *GroupOwner
+	resp, err := m.RequestExecutor.Do(ctx, req, &owner)
+	if err != nil {
+		return nil, resp, err
+	}
+	return owner, resp, nil
+}
+
+// DeleteGroupOwner removes the owner from the group
+func (m *APISupplement) DeleteGroupOwner(ctx context.Context, groupID, ownerID string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/groups/%s/owners/%s", groupID, ownerID)
+	req, err := m.RequestExecutor.NewRequest(http.MethodDelete, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
@@ -0,0 +1,14 @@
+package sdk
+
+const HookChannelTypeOAuth = "OAUTH"
+
+// HookChannelOAuthConfig the OAuth 2.0 client credentials properties of the inline and event hook channels,
+// they are only used by the channels of the OAUTH type
+type HookChannelOAuthConfig struct {
+	AuthType     string `json:"authType,omitempty"`
+	ClientId     string `json:"clientId,omitempty"`
+	ClientSecret string `json:"clientSecret,omitempty"`
+	HookKeyId    string `json:"hookKeyId,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	TokenUrl     string `json:"tokenUrl,omitempty"`
+}
@@ -0,0 +1,72 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+// InlineHook okta.InlineHook with the channel which supports the OAuth 2.0 client credentials authentication
+type InlineHook struct {
+	okta.InlineHook
+	Channel *InlineHookChannel `json:"channel,omitempty"`
+}
+
+type InlineHookChannel struct {
+	Config  *InlineHookChannelConfig `json:"config,omitempty"`
+	Type    string                   `json:"type,omitempty"`
+	Version string                   `json:"version,omitempty"`
+}
+
+// InlineHookChannelConfig the OAuth properties are only used by the channels of the OAUTH type
+type InlineHookChannelConfig struct {
+	okta.InlineHookChannelConfig
+	HookChannelOAuthConfig
+}
+
+// GetInlineHook gets inline hook by ID
+func (m *APISupplement) GetInlineHook(ctx context.Context, id string) (*InlineHook, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/inlineHooks/%s", id)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var inlineHook *InlineHook
+	resp, err := m.RequestExecutor.Do(ctx, req, &inlineHook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return inlineHook, resp, nil
+}
+
+// CreateInlineHook creates inline hook
+func (m *APISupplement) CreateInlineHook(ctx context.Context, body InlineHook) (*InlineHook, *okta.Response, error) {
+	url := "/api/v1/inlineHooks"
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var inlineHook *InlineHook
+	resp, err := m.RequestExecutor.Do(ctx, req, &inlineHook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return inlineHook, resp, nil
+}
+
+// UpdateInlineHook replaces inline hook
+func (m *APISupplement) UpdateInlineHook(ctx context.Context, id string, body InlineHook) (*InlineHook, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/inlineHooks/%s", id)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var inlineHook *InlineHook
+	resp, err := m.RequestExecutor.Do(ctx, req, &inlineHook)
+	if err != nil {
+		return nil, resp, err
+	}
+	return inlineHook, resp, nil
+}
@@ -0,0 +1,98 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+)
+
+const EnhancedDynamicNetworkZoneType = "DYNAMIC_V2"
+
+// EnhancedDynamicNetworkZone network zone of the DYNAMIC_V2 type, its conditions are include/exclude objects
+// instead of the lists of the IP and DYNAMIC zones, so it can't be handled with okta.NetworkZone
+type EnhancedDynamicNetworkZone struct {
+	Id                  string                               `json:"id,omitempty"`
+	Name                string                               `json:"name,omitempty"`
+	Type                string                               `json:"type,omitempty"`
+	Status              string                               `json:"status,omitempty"`
+	Usage               string                               `json:"usage,omitempty"`
+	System              *bool                                `json:"system,omitempty"`
+	Locations           *EnhancedDynamicNetworkZoneLocations `json:"locations,omitempty"`
+	Asns                *EnhancedDynamicNetworkZoneCondition `json:"asns,omitempty"`
+	IpServiceCategories *EnhancedDynamicNetworkZoneCondition `json:"ipServiceCategories,omitempty"`
+}
+
+type EnhancedDynamicNetworkZoneLocations struct {
+	Include []*okta.NetworkZoneLocation `json:"include,omitempty"`
+	Exclude []*okta.NetworkZoneLocation `json:"exclude,omitempty"`
+}
+
+type EnhancedDynamicNetworkZoneCondition struct {
+	Include []string `json:"include,omitempty"`
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// GetEnhancedDynamicNetworkZone gets enhanced dynamic network zone by ID
+func (m *APISupplement) GetEnhancedDynamicNetworkZone(ctx context.Context, id string) (*EnhancedDynamicNetworkZone, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/zones/%s", id)
+	req, err := m.RequestExecutor.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var zone *EnhancedDynamicNetworkZone
+	resp, err := m.RequestExecutor.Do(ctx, req, &zone)
+	if err != nil {
+		return nil, resp, err
+	}
+	return zone, resp, nil
+}
+
+// CreateEnhancedDynamicNetworkZone creates enhanced dynamic network zone
+func (m *APISupplement) CreateEnhancedDynamicNetworkZone(ctx context.Context, body EnhancedDynamicNetworkZone) (*EnhancedDynamicNetworkZone, *okta.Response, error) {
+	url := "/api/v1/zones"
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var zone *EnhancedDynamicNetworkZone
+	resp, err := m.RequestExecutor.Do(ctx, req, &zone)
+	if err != nil {
+		return nil, resp, err
+	}
+	return zone, resp, nil
+}
+
+// UpdateEnhancedDynamicNetworkZone replaces enhanced dynamic network zone
+func (m *APISupplement) UpdateEnhancedDynamicNetworkZone(ctx context.Context, id string, body EnhancedDynamicNetworkZone) (*EnhancedDynamicNetworkZone, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/zones/%s", id)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var zone *EnhancedDynamicNetworkZone
+	resp, err := m.RequestExecutor.Do(ctx, req, &zone)
+	if err != nil {
+		return nil, resp, err
+	}
+	return zone, resp, nil
+}
+
+func (m *APISupplement) ActivateEnhancedDynamicNetworkZone(ctx context.Context, id string) (*okta.Response, error) {
+	return m.changeEnhancedDynamicNetworkZoneLifecycle(ctx, id, "activate")
+}
+
+func (m *APISupplement) DeactivateEnhancedDynamicNetworkZone(ctx context.Context, id string) (*okta.Response, error) {
+	return m.changeEnhancedDynamicNetworkZoneLifecycle(ctx, id, "deactivate")
+}
+
+// changeEnhancedDynamicNetworkZoneLifecycle the response body is ignored, since okta.NetworkZone can't decode it
+func (m *APISupplement) changeEnhancedDynamicNetworkZoneLifecycle(ctx context.Context, id, action string) (*okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/zones/%s/lifecycle/%s", id, action)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return m.RequestExecutor.Do(ctx, req, nil)
+}
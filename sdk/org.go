@@ -8,10 +8,26 @@ import (
 )
 
 type OktaOrganization struct {
-	Id       string      `json:"id"`
-	Pipeline string      `json:"pipeline"`
-	Links    interface{} `json:"_links,omitempty"`
-	Settings interface{} `json:"settings,omitempty"`
+	Id       string                    `json:"id"`
+	Pipeline string                    `json:"pipeline"`
+	Links    *OktaOrganizationLinks    `json:"_links,omitempty"`
+	Settings *OktaOrganizationSettings `json:"settings,omitempty"`
+}
+
+// OktaOrganizationLinks organization link is the Okta domain of the org, alternate link is its custom domain (if any)
+type OktaOrganizationLinks struct {
+	Organization *OktaOrganizationLink `json:"organization,omitempty"`
+	Alternate    *OktaOrganizationLink `json:"alternate,omitempty"`
+}
+
+type OktaOrganizationLink struct {
+	Href string `json:"href,omitempty"`
+}
+
+type OktaOrganizationSettings struct {
+	AnalyticsCollectionEnabled bool `json:"analyticsCollectionEnabled"`
+	BugReportingEnabled        bool `json:"bugReportingEnabled"`
+	OmEnabled                  bool `json:"omEnabled"`
 }
 
 // GetWellKnownOktaOrganization calls GET /.well-known/okta-organization that
@@ -0,0 +1,93 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/okta/okta-sdk-golang/v2/okta"
+	"github.com/okta/okta-sdk-golang/v2/okta/query"
+)
+
+const (
+	PrincipalTypeSSWSToken   = "SSWS_TOKEN"
+	PrincipalTypeOAuthClient = "OAUTH_CLIENT"
+)
+
+// PrincipalRateLimit percentages of the org rate limit capacity, which can be consumed by the API token or the OAuth 2.0 app
+type PrincipalRateLimit struct {
+	Id                           string     `json:"id,omitempty"`
+	PrincipalId                  string     `json:"principalId,omitempty"`
+	PrincipalType                string     `json:"principalType,omitempty"`
+	DefaultPercentage            *int64     `json:"defaultPercentage,omitempty"`
+	DefaultConcurrencyPercentage *int64     `json:"defaultConcurrencyPercentage,omitempty"`
+	OrgId                        string     `json:"orgId,omitempty"`
+	CreatedBy                    string     `json:"createdBy,omitempty"`
+	CreatedDate                  *time.Time `json:"createdDate,omitempty"`
+	LastUpdatedBy                string     `json:"lastUpdatedBy,omitempty"`
+	LastUpdate                   *time.Time `json:"lastUpdate,omitempty"`
+}
+
+// ListPrincipalRateLimits lists the principal rate limits, the filter by the principal type is required
+func (m *APISupplement) ListPrincipalRateLimits(ctx context.Context, qp *query.Params) ([]*PrincipalRateLimit, *okta.Response, error) {
+	url := "/api/v1/principal-rate-limits"
+	if qp != nil {
+		url += qp.String()
+	}
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rateLimits []*PrincipalRateLimit
+	resp, err := m.RequestExecutor.Do(ctx, req, &rateLimits)
+	if err != nil {
+		return nil, resp, err
+	}
+	return rateLimits, resp, nil
+}
+
+// GetPrincipalRateLimit gets principal rate limit by ID
+func (m *APISupplement) GetPrincipalRateLimit(ctx context.Context, id string) (*PrincipalRateLimit, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/principal-rate-limits/%s", id)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rateLimit *PrincipalRateLimit
+	resp, err := m.RequestExecutor.Do(ctx, req, &rateLimit)
+	if err != nil {
+		return nil, resp, err
+	}
+	return rateLimit, resp, nil
+}
+
+// CreatePrincipalRateLimit creates principal rate limit
+func (m *APISupplement) CreatePrincipalRateLimit(ctx context.Context, body PrincipalRateLimit) (*PrincipalRateLimit, *okta.Response, error) {
+	url := "/api/v1/principal-rate-limits"
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPost, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rateLimit *PrincipalRateLimit
+	resp, err := m.RequestExecutor.Do(ctx, req, &rateLimit)
+	if err != nil {
+		return nil, resp, err
+	}
+	return rateLimit, resp, nil
+}
+
+// UpdatePrincipalRateLimit replaces principal rate limit
+func (m *APISupplement) UpdatePrincipalRateLimit(ctx context.Context, id string, body PrincipalRateLimit) (*PrincipalRateLimit, *okta.Response, error) {
+	url := fmt.Sprintf("/api/v1/principal-rate-limits/%s", id)
+	req, err := m.RequestExecutor.WithAccept("application/json").WithContentType("application/json").NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	var rateLimit *PrincipalRateLimit
+	resp, err := m.RequestExecutor.Do(ctx, req, &rateLimit)
+	if err != nil {
+		return nil, resp, err
+	}
+	return rateLimit, resp, nil
+}